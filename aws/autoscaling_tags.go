@@ -4,13 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // autoscalingTagSchema returns the schema to use for the tag element.
@@ -241,17 +242,29 @@ func autoscalingTagFromMap(attr map[string]interface{}, resourceID string) (*aut
 		ResourceType:      aws.String("auto-scaling-group"),
 	}
 
-	if tagIgnoredAutoscaling(t) {
+	if strings.HasPrefix(aws.StringValue(t.Key), keyvaluetags.AwsTagKeyPrefix) {
+		log.Printf("[DEBUG] Skipping AWS-reserved Auto Scaling Group tag: %s", aws.StringValue(t.Key))
 		return nil, nil
 	}
 
 	return t, nil
 }
 
-// autoscalingTagDescriptionsToSlice turns the list of tags into a slice.
-func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription) []map[string]interface{} {
+// autoscalingTagDescriptionsToSlice turns the list of tags into a slice,
+// skipping any tags ignored by the provider configuration.
+func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription, ignoreTagsConfig *keyvaluetags.IgnoreConfig) []map[string]interface{} {
+	tagsMap := make(map[string]*string, len(ts))
+	for _, t := range ts {
+		tagsMap[aws.StringValue(t.Key)] = t.Value
+	}
+	keptTags := keyvaluetags.New(tagsMap).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
 	tags := make([]map[string]interface{}, 0, len(ts))
 	for _, t := range ts {
+		if _, ok := keptTags[aws.StringValue(t.Key)]; !ok {
+			continue
+		}
+
 		tags = append(tags, map[string]interface{}{
 			"key":                 *t.Key,
 			"value":               *t.Value,
@@ -271,18 +284,3 @@ func setToMapByKey(s *schema.Set) map[string]interface{} {
 
 	return result
 }
-
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
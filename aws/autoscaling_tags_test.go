@@ -5,7 +5,6 @@ import (
 	"reflect"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -141,18 +140,24 @@ func testAccCheckAutoscalingTagNotExists(ts *[]*autoscaling.TagDescription, key
 }
 
 func TestIgnoringTagsAutoscaling(t *testing.T) {
-	var ignoredTags []*autoscaling.Tag
-	ignoredTags = append(ignoredTags, &autoscaling.Tag{
-		Key:   aws.String("aws:cloudformation:logical-id"),
-		Value: aws.String("foo"),
+	var ignoredTags []map[string]interface{}
+	ignoredTags = append(ignoredTags, map[string]interface{}{
+		"key":                 "aws:cloudformation:logical-id",
+		"value":               "foo",
+		"propagate_at_launch": true,
 	})
-	ignoredTags = append(ignoredTags, &autoscaling.Tag{
-		Key:   aws.String("aws:foo:bar"),
-		Value: aws.String("baz"),
+	ignoredTags = append(ignoredTags, map[string]interface{}{
+		"key":                 "aws:foo:bar",
+		"value":               "baz",
+		"propagate_at_launch": true,
 	})
 	for _, tag := range ignoredTags {
-		if !tagIgnoredAutoscaling(tag) {
-			t.Fatalf("Tag %v with value %v not ignored, but should be!", *tag.Key, *tag.Value)
+		result, err := autoscalingTagFromMap(tag, "test-asg")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if result != nil {
+			t.Fatalf("Tag %v with value %v not ignored, but should be!", tag["key"], tag["value"])
 		}
 	}
 }
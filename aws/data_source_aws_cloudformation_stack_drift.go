@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsCloudFormationStackDrift() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFormationStackDriftRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logical_resource_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"detection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detection_status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_stack_resource_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"resource_drift": cloudFormationStackResourceDriftSchema(),
+			"stack_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFormationStackDriftRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+	name := d.Get("name").(string)
+
+	var logicalResourceIds []*string
+	if v, ok := d.GetOk("logical_resource_ids"); ok && v.(*schema.Set).Len() > 0 {
+		logicalResourceIds = expandStringSet(v.(*schema.Set))
+	}
+
+	status, err := detectCloudFormationStackDrift(conn, name, logicalResourceIds)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Listing CloudFormation Stack (%s) resource drifts", name)
+	drifts, err := listCloudFormationStackResourceDrifts(conn, name)
+	if err != nil {
+		return fmt.Errorf("error listing CloudFormation Stack (%s) resource drifts: %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(status.StackDriftDetectionId))
+	d.Set("detection_status", status.DetectionStatus)
+	d.Set("detection_status_reason", status.DetectionStatusReason)
+	d.Set("drift_status", status.StackDriftStatus)
+	d.Set("drifted_stack_resource_count", status.DriftedStackResourceCount)
+	d.Set("stack_id", status.StackId)
+	d.Set("timestamp", aws.TimeValue(status.Timestamp).Format(time.RFC3339))
+
+	if err := d.Set("resource_drift", flattenCloudFormationStackResourceDrifts(drifts)); err != nil {
+		return fmt.Errorf("error setting resource_drift: %s", err)
+	}
+
+	return nil
+}
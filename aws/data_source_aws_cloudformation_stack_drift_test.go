@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSCloudFormationStackDriftDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cloudformation_stack_drift.test"
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackDriftDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "detection_status", cloudformation.StackDriftDetectionStatusDetectionComplete),
+					resource.TestCheckResourceAttr(dataSourceName, "drift_status", cloudformation.StackDriftStatusInSync),
+					resource.TestCheckResourceAttr(dataSourceName, "drifted_stack_resource_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drift.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drift.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_drift.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusInSync),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stack_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFormationStackDriftDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": %[1]q}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+
+data "aws_cloudformation_stack_drift" "test" {
+  name = "${aws_cloudformation_stack.test.name}"
+}
+`, rName)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsEfsFileSystem() *schema.Resource {
@@ -56,6 +57,7 @@ func dataSourceAwsEfsFileSystem() *schema.Resource {
 
 func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
 	efsconn := meta.(*AWSClient).efsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	describeEfsOpts := &efs.DescribeFileSystemsInput{}
 
@@ -106,7 +108,7 @@ func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = d.Set("tags", keyvaluetags.EfsKeyValueTags(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsElastiCacheCluster() *schema.Resource {
@@ -152,6 +153,7 @@ func dataSourceAwsElastiCacheCluster() *schema.Resource {
 
 func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Get("cluster_id").(string)),
@@ -234,7 +236,7 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 	if len(tagResp.TagList) > 0 {
 		et = tagResp.TagList
 	}
-	d.Set("tags", tagsToMapEC(et))
+	d.Set("tags", keyvaluetags.ElasticacheKeyValueTags(et).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 
 	return nil
 
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func dataSourceAwsKinesisVideoStream() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisVideoStreamRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_retention_in_hours": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"device_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisVideoStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+	name := d.Get("name").(string)
+
+	output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+		StreamName: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", name, err)
+	}
+
+	stream := output.StreamInfo
+
+	if stream == nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(stream.StreamARN))
+	d.Set("arn", stream.StreamARN)
+	d.Set("creation_time", aws.TimeValue(stream.CreationTime).Format(time.RFC3339))
+	d.Set("data_retention_in_hours", stream.DataRetentionInHours)
+	d.Set("device_name", stream.DeviceName)
+	d.Set("kms_key_id", stream.KmsKeyId)
+	d.Set("media_type", stream.MediaType)
+	d.Set("name", stream.StreamName)
+	d.Set("status", stream.Status)
+	d.Set("version", stream.Version)
+
+	tags, err := keyvaluetags.KinesisvideoListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
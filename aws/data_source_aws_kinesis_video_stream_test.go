@@ -0,0 +1,56 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSKinesisVideoStreamDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_kinesis_video_stream.test"
+	resourceName := "aws_kinesis_video_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccHasServicePreCheck("kinesisvideo", t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisVideoStreamDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_time", resourceName, "creation_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_retention_in_hours", resourceName, "data_retention_in_hours"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "device_name", resourceName, "device_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "media_type", resourceName, "media_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSKinesisVideoStreamDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+  device_name             = "kinesis-video-device-name"
+  media_type              = "video/h264"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_kinesis_video_stream" "test" {
+  name = "${aws_kinesis_video_stream.test.name}"
+}
+`, rName)
+}
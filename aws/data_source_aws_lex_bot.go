@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexBotRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validateLexName,
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validateLexVersion,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(name),
+		VersionOrAlias: aws.String(version),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s) version (%s): %s", name, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, version))
+
+	botARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", name),
+	}.String()
+	d.Set("arn", botARN)
+
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("version", output.Version)
+	d.Set("voice_id", output.VoiceId)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsLexBotAlias() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexBotAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bot_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validateLexName,
+				),
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 100),
+					validateLexName,
+				),
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	botName := d.Get("bot_name").(string)
+	name := d.Get("name").(string)
+	id := fmt.Sprintf("%s:%s", botName, name)
+
+	output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+		BotName: aws.String(botName),
+		Name:    aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot Alias (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	aliasARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", id),
+	}.String()
+	d.Set("arn", aliasARN)
+
+	d.Set("bot_name", output.BotName)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexIntentRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLexName,
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validateLexVersion,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s) version (%s): %s", name, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, version))
+
+	intentARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("intent:%s", name),
+	}.String()
+	d.Set("arn", intentARN)
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	d.Set("version", output.Version)
+
+	return nil
+}
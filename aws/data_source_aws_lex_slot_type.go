@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLexSlotTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLexName,
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lexVersionLatest,
+				ValidateFunc: validateLexVersion,
+			},
+		},
+	}
+}
+
+func dataSourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Slot Type (%s) version (%s): %s", name, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, version))
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("value_selection_strategy", output.ValueSelectionStrategy)
+	d.Set("version", output.Version)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(output.EnumerationValues)); err != nil {
+		return fmt.Errorf("error setting enumeration_value: %s", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsNatGateway() *schema.Resource {
@@ -58,6 +59,7 @@ func dataSourceAwsNatGateway() *schema.Resource {
 
 func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	req := &ec2.DescribeNatGatewaysInput{}
 
@@ -91,7 +93,7 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if tags, ok := d.GetOk("tags"); ok {
 		req.Filter = append(req.Filter, buildEC2TagFilterList(
-			tagsFromMap(tags.(map[string]interface{})),
+			keyvaluetags.New(tags.(map[string]interface{})).IgnoreAws().Ec2Tags(),
 		)...)
 	}
 
@@ -122,7 +124,7 @@ func dataSourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("state", ngw.State)
 	d.Set("subnet_id", ngw.SubnetId)
 	d.Set("vpc_id", ngw.VpcId)
-	d.Set("tags", tagsToMap(ngw.Tags))
+	d.Set("tags", keyvaluetags.Ec2KeyValueTags(ngw.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 
 	for _, address := range ngw.NatGatewayAddresses {
 		if *address.AllocationId != "" {
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsRamResourceShare() *schema.Resource {
@@ -71,6 +72,7 @@ func dataSourceAwsRamResourceShare() *schema.Resource {
 
 func dataSourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ramconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	name := d.Get("name").(string)
 	owner := d.Get("resource_owner").(string)
@@ -108,7 +110,7 @@ func dataSourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{})
 				d.Set("owning_account_id", aws.StringValue(r.OwningAccountId))
 				d.Set("status", aws.StringValue(r.Status))
 
-				if err := d.Set("tags", tagsToMapRAM(r.Tags)); err != nil {
+				if err := d.Set("tags", keyvaluetags.RamKeyValueTags(r.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 					return fmt.Errorf("error setting tags: %s", err)
 				}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsRedshiftCluster() *schema.Resource {
@@ -170,6 +171,7 @@ func dataSourceAwsRedshiftCluster() *schema.Resource {
 
 func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	cluster := d.Get("cluster_identifier").(string)
 
@@ -248,7 +250,7 @@ func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{})
 	d.Set("port", rsc.Endpoint.Port)
 	d.Set("preferred_maintenance_window", rsc.PreferredMaintenanceWindow)
 	d.Set("publicly_accessible", rsc.PubliclyAccessible)
-	d.Set("tags", tagsToMapRedshift(rsc.Tags))
+	d.Set("tags", keyvaluetags.RedshiftKeyValueTags(rsc.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	d.Set("vpc_id", rsc.VpcId)
 
 	var vpcg []string
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsS3BucketObject() *schema.Resource {
@@ -107,6 +108,7 @@ func dataSourceAwsS3BucketObject() *schema.Resource {
 
 func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).s3conn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -211,7 +213,7 @@ func dataSourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapS3(tagResp.TagSet))
+	d.Set("tags", keyvaluetags.S3KeyValueTags(tagResp.TagSet).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 
 	return nil
 }
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsShieldSubscription() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsShieldSubscriptionRead,
+
+		Schema: map[string]*schema.Schema{
+			"auto_renew": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_commitment_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsShieldSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	stateOutput, err := conn.GetSubscriptionState(&shield.GetSubscriptionStateInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription State: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+	d.Set("subscription_state", stateOutput.SubscriptionState)
+
+	// Subscription details are only available for active subscriptions.
+	if aws.StringValue(stateOutput.SubscriptionState) != shield.SubscriptionStateActive {
+		d.Set("auto_renew", "")
+		d.Set("end_time", "")
+		d.Set("limits", []interface{}{})
+		d.Set("start_time", "")
+		d.Set("time_commitment_in_seconds", 0)
+		return nil
+	}
+
+	output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %s", err)
+	}
+
+	subscription := output.Subscription
+
+	if subscription == nil {
+		return fmt.Errorf("error reading Shield Subscription: empty response")
+	}
+
+	d.Set("auto_renew", subscription.AutoRenew)
+	d.Set("time_commitment_in_seconds", subscription.TimeCommitmentInSeconds)
+
+	if subscription.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(subscription.EndTime).Format(time.RFC3339))
+	}
+
+	if subscription.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(subscription.StartTime).Format(time.RFC3339))
+	}
+
+	if err := d.Set("limits", flattenShieldLimits(subscription.Limits)); err != nil {
+		return fmt.Errorf("error setting limits: %s", err)
+	}
+
+	return nil
+}
+
+func flattenShieldLimits(limits []*shield.Limit) []interface{} {
+	l := make([]interface{}, 0, len(limits))
+
+	for _, limit := range limits {
+		if limit == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"max":  aws.Int64Value(limit.Max),
+			"type": aws.StringValue(limit.Type),
+		})
+	}
+
+	return l
+}
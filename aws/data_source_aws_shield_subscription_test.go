@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSShieldSubscriptionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_shield_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldSubscriptionDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceAttrAccountID(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscription_state", shield.SubscriptionStateActive),
+					resource.TestCheckResourceAttrSet(dataSourceName, "auto_renew"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "time_commitment_in_seconds"),
+				),
+			},
+		},
+	})
+}
+
+const testAccShieldSubscriptionDataSourceConfig = `
+data "aws_shield_subscription" "test" {}
+`
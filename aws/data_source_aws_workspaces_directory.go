@@ -0,0 +1,161 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsWorkspacesDirectory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsWorkspacesDirectoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"directory_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_role_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_group_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"registration_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"workspace_creation_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_ou": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enable_internet_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enable_work_docs": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"user_enabled_as_local_administrator": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"workspace_security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsWorkspacesDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+	directoryID := d.Get("directory_id").(string)
+
+	output, err := conn.DescribeWorkspaceDirectories(&workspaces.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: []*string{aws.String(directoryID)},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Directory (%s): %s", directoryID, err)
+	}
+
+	if len(output.Directories) == 0 || output.Directories[0] == nil {
+		return fmt.Errorf("WorkSpaces Directory (%s) not found, it must be registered with WorkSpaces", directoryID)
+	}
+
+	directory := output.Directories[0]
+
+	d.SetId(aws.StringValue(directory.DirectoryId))
+	d.Set("alias", directory.Alias)
+	d.Set("customer_user_name", directory.CustomerUserName)
+	d.Set("directory_id", directory.DirectoryId)
+	d.Set("directory_name", directory.DirectoryName)
+	d.Set("directory_type", directory.DirectoryType)
+	d.Set("iam_role_id", directory.IamRoleId)
+	d.Set("registration_code", directory.RegistrationCode)
+	d.Set("state", directory.State)
+	d.Set("workspace_security_group_id", directory.WorkspaceSecurityGroupId)
+
+	if err := d.Set("dns_ip_addresses", flattenStringSet(directory.DnsIpAddresses)); err != nil {
+		return fmt.Errorf("error setting dns_ip_addresses: %s", err)
+	}
+
+	if err := d.Set("ip_group_ids", flattenStringSet(directory.IpGroupIds)); err != nil {
+		return fmt.Errorf("error setting ip_group_ids: %s", err)
+	}
+
+	if err := d.Set("subnet_ids", flattenStringSet(directory.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("workspace_creation_properties", flattenWorkspacesDefaultWorkspaceCreationProperties(directory.WorkspaceCreationProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_creation_properties: %s", err)
+	}
+
+	return nil
+}
+
+func flattenWorkspacesDefaultWorkspaceCreationProperties(properties *workspaces.DefaultWorkspaceCreationProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"custom_security_group_id":            aws.StringValue(properties.CustomSecurityGroupId),
+		"default_ou":                          aws.StringValue(properties.DefaultOu),
+		"enable_internet_access":              aws.BoolValue(properties.EnableInternetAccess),
+		"enable_work_docs":                    aws.BoolValue(properties.EnableWorkDocs),
+		"user_enabled_as_local_administrator": aws.BoolValue(properties.UserEnabledAsLocalAdministrator),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsWorkspacesDirectory_basic(t *testing.T) {
+	dataSourceName := "data.aws_workspaces_directory.test"
+	directoryID := os.Getenv("WORKSPACES_DIRECTORY_ID")
+
+	if directoryID == "" {
+		t.Skip("Environment variable WORKSPACES_DIRECTORY_ID is not set")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsWorkspacesDirectoryConfig(directoryID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "directory_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "registration_code"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "REGISTERED"),
+					resource.TestCheckResourceAttr(dataSourceName, "workspace_creation_properties.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "workspace_security_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsWorkspacesDirectoryConfig(directoryID string) string {
+	return fmt.Sprintf(`
+data "aws_workspaces_directory" "test" {
+  directory_id = %q
+}
+`, directoryID)
+}
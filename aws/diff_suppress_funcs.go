@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"log"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/awspolicyequivalence"
@@ -81,6 +83,98 @@ func suppressEquivalentJsonDiffs(k, old, new string, d *schema.ResourceData) boo
 	return jsonBytesEqual(ob.Bytes(), nb.Bytes())
 }
 
+// suppressAwsSdkSettingsJsonDiffs returns a DiffSuppressFunc for JSON documents
+// that are decoded into AWS SDK structures, e.g. MediaConvert preset settings.
+// Both values are decoded into the structure and encoded again before being
+// compared, so only differences in formatting and field name casing are
+// suppressed.
+// Settings defaulted by the API are handled on read, see
+// flattenAwsSdkSettingsJsonWithConfigured.
+func suppressAwsSdkSettingsJsonDiffs(newSettings func() interface{}) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldValue, err := normalizeAwsSdkSettingsJson(old, newSettings())
+		if err != nil {
+			return false
+		}
+
+		newValue, err := normalizeAwsSdkSettingsJson(new, newSettings())
+		if err != nil {
+			return false
+		}
+
+		return reflect.DeepEqual(oldValue, newValue)
+	}
+}
+
+func normalizeAwsSdkSettingsJson(rawSettings string, settings interface{}) (interface{}, error) {
+	if err := json.Unmarshal([]byte(rawSettings), settings); err != nil {
+		return nil, err
+	}
+
+	settingsJSON, err := flattenAwsSdkSettingsJson(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(settingsJSON), &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// awsSdkSettingsJsonSubset returns whether every setting in subset is also
+// present, with the same value, in set.
+func awsSdkSettingsJsonSubset(subset, set interface{}) bool {
+	switch subset := subset.(type) {
+	case map[string]interface{}:
+		set, ok := set.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for k, v := range subset {
+			if !awsSdkSettingsJsonSubset(v, set[k]) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		set, ok := set.([]interface{})
+		if !ok || len(subset) != len(set) {
+			return false
+		}
+
+		for i := range subset {
+			if !awsSdkSettingsJsonSubset(subset[i], set[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(subset, set)
+	}
+}
+
+// suppressEquivalentRFC3339TimeDiffs suppresses differences between RFC3339
+// timestamps that represent the same instant, e.g. with different offsets.
+func suppressEquivalentRFC3339TimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
 func suppressOpenIdURL(k, old, new string, d *schema.ResourceData) bool {
 	oldUrl, err := url.Parse(old)
 	if err != nil {
@@ -3,6 +3,7 @@ package aws
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -320,3 +321,80 @@ func TestSuppressRoute53ZoneNameWithTrailingDot(t *testing.T) {
 		}
 	}
 }
+
+func TestSuppressEquivalentRFC3339TimeDiffs(t *testing.T) {
+	testCases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "2019-11-01T00:00:00Z",
+			New:      "2019-11-01T00:00:00Z",
+			Suppress: true,
+		},
+		{
+			Old:      "2019-11-01T00:00:00Z",
+			New:      "2019-11-01T02:00:00+02:00",
+			Suppress: true,
+		},
+		{
+			Old:      "2019-11-01T00:00:00Z",
+			New:      "2019-11-01T00:00:00+02:00",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "2019-11-01T00:00:00Z",
+			Suppress: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if got := suppressEquivalentRFC3339TimeDiffs("test_property", tc.Old, tc.New, nil); got != tc.Suppress {
+			t.Errorf("%d: expected %t, got %t", i, tc.Suppress, got)
+		}
+	}
+}
+
+func TestSuppressAwsSdkSettingsJsonDiffs(t *testing.T) {
+	testCases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      `{"containerSettings":{"container":"MP4"}}`,
+			New:      `{"ContainerSettings":{"Container":"MP4"}}`,
+			Suppress: true,
+		},
+		{
+			Old:      `{"containerSettings":{"container":"MP4","mp4Settings":{"cslgAtom":"INCLUDE"}}}`,
+			New:      `{"ContainerSettings":{"Container":"MP4"}}`,
+			Suppress: false,
+		},
+		{
+			Old:      `{"containerSettings":{"container":"MP4"}}`,
+			New:      `{"ContainerSettings":{"Container":"MOV"}}`,
+			Suppress: false,
+		},
+		{
+			Old:      `{"audioDescriptions":[{"audioSourceName":"Audio Selector 1"}]}`,
+			New:      `{"AudioDescriptions":[{"AudioSourceName":"Audio Selector 1"},{"AudioSourceName":"Audio Selector 2"}]}`,
+			Suppress: false,
+		},
+		{
+			Old:      `{"containerSettings":{"container":"MP4"}}`,
+			New:      `not json`,
+			Suppress: false,
+		},
+	}
+
+	suppress := suppressAwsSdkSettingsJsonDiffs(func() interface{} { return &mediaconvert.PresetSettings{} })
+
+	for i, tc := range testCases {
+		if got := suppress("settings_json", tc.Old, tc.New, nil); got != tc.Suppress {
+			t.Errorf("%d: expected %t, got %t", i, tc.Suppress, got)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dxVirtualInterfaceRead(id string, conn *directconnect.DirectConnect) (*directconnect.VirtualInterface, error) {
@@ -39,8 +40,12 @@ func dxVirtualInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := setTagsDX(conn, d, d.Get("arn").(string)); err != nil {
-		return fmt.Errorf("error setting Direct Connect virtual interface (%s) tags: %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.DirectconnectUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error setting Direct Connect virtual interface (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return nil
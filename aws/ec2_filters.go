@@ -68,7 +68,7 @@ func buildEC2AttributeFilterList(attrs map[string]string) []*ec2.Filter {
 //
 // It is conventional for an EC2 data source to include an attribute called
 // "tags" which conforms to the schema returned by the tagsSchema() function.
-// The value of this can then be converted to a tags slice using tagsFromMap,
+// The value of this can then be converted to a tags slice using keyvaluetags.New().Ec2Tags(),
 // and the result finally passed in to this function.
 //
 // In Terraform configuration this would then look like this, to constrain
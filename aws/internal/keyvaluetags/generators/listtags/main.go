@@ -0,0 +1,408 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const filename = `list_tags_gen.go`
+
+var serviceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"apigatewayv2",
+	"appmesh",
+	"appsync",
+	"athena",
+	"backup",
+	"cloudfront",
+	"cloudhsmv2",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidentityprovider",
+	"configservice",
+	"databasemigrationservice",
+	"datasync",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"directoryservice",
+	"docdb",
+	"dynamodb",
+	"ecr",
+	"ecs",
+	"efs",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticsearchservice",
+	"elb",
+	"elbv2",
+	"firehose",
+	"fsx",
+	"glue",
+	"guardduty",
+	"inspector",
+	"iot",
+	"kafka",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisanalyticsv2",
+	"kinesisvideo",
+	"kms",
+	"lambda",
+	"licensemanager",
+	"mediaconnect",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"rds",
+	"route53",
+	"route53resolver",
+	"sagemaker",
+	"securityhub",
+	"sfn",
+	"sns",
+	"sqs",
+	"ssm",
+	"storagegateway",
+	"swf",
+	"transfer",
+	"waf",
+	"workspaces",
+}
+
+type TemplateData struct {
+	ServiceNames []string
+}
+
+func main() {
+	// Always sort to reduce any potential generation churn
+	sort.Strings(serviceNames)
+
+	templateData := TemplateData{
+		ServiceNames: serviceNames,
+	}
+	templateFuncMap := template.FuncMap{
+		"ClientType":                           keyvaluetags.ServiceClientType,
+		"ListTagsFunction":                     ServiceListTagsFunction,
+		"ListTagsInputIdentifierField":         ServiceListTagsInputIdentifierField,
+		"ListTagsInputIdentifierRequiresSlice": ServiceListTagsInputIdentifierRequiresSlice,
+		"ListTagsInputResourceTypeField":       ServiceListTagsInputResourceTypeField,
+		"ListTagsOutputTagsField":              ServiceListTagsOutputTagsField,
+		"Title":                                strings.Title,
+	}
+
+	tmpl, err := template.New("listtags").Funcs(templateFuncMap).Parse(templateBody)
+
+	if err != nil {
+		log.Fatalf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tmpl.Execute(&buffer, templateData)
+
+	if err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s", err)
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		log.Fatalf("error creating file (%s): %s", filename, err)
+	}
+
+	defer f.Close()
+
+	_, err = f.Write(generatedFileContents)
+
+	if err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+var templateBody = `
+// Code generated by generators/listtags/main.go; DO NOT EDIT.
+
+package keyvaluetags
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+{{- range .ServiceNames }}
+	"github.com/aws/aws-sdk-go/service/{{ . }}"
+{{- end }}
+)
+{{- range .ServiceNames }}
+
+// {{ . | Title }}ListTags lists {{ . }} service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if . | ListTagsInputResourceTypeField }}, resourceType string{{ end }}) (KeyValueTags, error) {
+	input := &{{ . }}.{{ . | ListTagsFunction }}Input{
+		{{- if . | ListTagsInputIdentifierRequiresSlice }}
+		{{ . | ListTagsInputIdentifierField }}: aws.StringSlice([]string{identifier}),
+		{{- else }}
+		{{ . | ListTagsInputIdentifierField }}: aws.String(identifier),
+		{{- end }}
+		{{- if . | ListTagsInputResourceTypeField }}
+		{{ . | ListTagsInputResourceTypeField }}: aws.String(resourceType),
+		{{- end }}
+	}
+
+	output, err := conn.{{ . | ListTagsFunction }}(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return {{ . | Title }}KeyValueTags(output.{{ . | ListTagsOutputTagsField }}), nil
+}
+{{- end }}
+`
+
+// ServiceListTagsFunction determines the service tagging function.
+func ServiceListTagsFunction(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "ListTagsForCertificate"
+	case "acmpca":
+		return "ListTags"
+	case "apigateway":
+		return "GetTags"
+	case "apigatewayv2":
+		return "GetTags"
+	case "backup":
+		return "ListTags"
+	case "cloudhsmv2":
+		return "ListTags"
+	case "cloudtrail":
+		return "ListTags"
+	case "cloudwatchlogs":
+		return "ListTagsLogGroup"
+	case "dax":
+		return "ListTags"
+	case "directconnect":
+		return "DescribeTags"
+	case "dynamodb":
+		return "ListTagsOfResource"
+	case "efs":
+		return "DescribeTags"
+	case "elasticsearchservice":
+		return "ListTags"
+	case "elb":
+		return "DescribeTags"
+	case "elbv2":
+		return "DescribeTags"
+	case "firehose":
+		return "ListTagsForDeliveryStream"
+	case "glue":
+		return "GetTags"
+	case "kinesis":
+		return "ListTagsForStream"
+	case "kinesisvideo":
+		return "ListTagsForStream"
+	case "kms":
+		return "ListResourceTags"
+	case "lambda":
+		return "ListTags"
+	case "mq":
+		return "ListTags"
+	case "opsworks":
+		return "ListTags"
+	case "sagemaker":
+		return "ListTags"
+	case "sqs":
+		return "ListQueueTags"
+	case "workspaces":
+		return "DescribeTags"
+	default:
+		return "ListTagsForResource"
+	}
+}
+
+// ServiceListTagsInputIdentifierField determines the service tag identifier field.
+func ServiceListTagsInputIdentifierField(serviceName string) string {
+	switch serviceName {
+	case "acm":
+		return "CertificateArn"
+	case "acmpca":
+		return "CertificateAuthorityArn"
+	case "athena":
+		return "ResourceARN"
+	case "cloudfront":
+		return "Resource"
+	case "cloudhsmv2":
+		return "ResourceId"
+	case "cloudtrail":
+		return "ResourceIdList"
+	case "cloudwatch":
+		return "ResourceARN"
+	case "cloudwatchevents":
+		return "ResourceARN"
+	case "cloudwatchlogs":
+		return "LogGroupName"
+	case "dax":
+		return "ResourceName"
+	case "devicefarm":
+		return "ResourceARN"
+	case "directconnect":
+		return "ResourceArns"
+	case "directoryservice":
+		return "ResourceId"
+	case "docdb":
+		return "ResourceName"
+	case "efs":
+		return "FileSystemId"
+	case "elasticache":
+		return "ResourceName"
+	case "elasticsearchservice":
+		return "ARN"
+	case "elb":
+		return "LoadBalancerNames"
+	case "elbv2":
+		return "ResourceArns"
+	case "firehose":
+		return "DeliveryStreamName"
+	case "fsx":
+		return "ResourceARN"
+	case "kinesis":
+		return "StreamName"
+	case "kinesisanalytics":
+		return "ResourceARN"
+	case "kinesisanalyticsv2":
+		return "ResourceARN"
+	case "kinesisvideo":
+		return "StreamARN"
+	case "kms":
+		return "KeyId"
+	case "lambda":
+		return "Resource"
+	case "mediaconvert":
+		return "Arn"
+	case "mediastore":
+		return "Resource"
+	case "neptune":
+		return "ResourceName"
+	case "organizations":
+		return "ResourceId"
+	case "rds":
+		return "ResourceName"
+	case "route53":
+		return "ResourceId"
+	case "sqs":
+		return "QueueUrl"
+	case "ssm":
+		return "ResourceId"
+	case "storagegateway":
+		return "ResourceARN"
+	case "transfer":
+		return "Arn"
+	case "waf":
+		return "ResourceARN"
+	case "workspaces":
+		return "ResourceId"
+	default:
+		return "ResourceArn"
+	}
+}
+
+// ServiceListTagsInputIdentifierRequiresSlice determines if the service list tagging resource field requires a slice.
+func ServiceListTagsInputIdentifierRequiresSlice(serviceName string) string {
+	switch serviceName {
+	case "cloudtrail":
+		return "yes"
+	case "directconnect":
+		return "yes"
+	case "elb":
+		return "yes"
+	case "elbv2":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
+// ServiceListTagsInputResourceTypeField determines the service list tagging resource type field.
+func ServiceListTagsInputResourceTypeField(serviceName string) string {
+	switch serviceName {
+	case "route53":
+		return "ResourceType"
+	case "ssm":
+		return "ResourceType"
+	default:
+		return ""
+	}
+}
+
+// ServiceListTagsOutputTagsField determines the service list tag field.
+func ServiceListTagsOutputTagsField(serviceName string) string {
+	switch serviceName {
+	case "cloudfront":
+		return "Tags.Items"
+	case "cloudhsmv2":
+		return "TagList"
+	case "cloudtrail":
+		return "ResourceTagList[0].TagsList"
+	case "databasemigrationservice":
+		return "TagList"
+	case "directconnect":
+		return "ResourceTags[0].Tags"
+	case "docdb":
+		return "TagList"
+	case "elasticache":
+		return "TagList"
+	case "elasticbeanstalk":
+		return "ResourceTags"
+	case "elasticsearchservice":
+		return "TagList"
+	case "elb":
+		return "TagDescriptions[0].Tags"
+	case "elbv2":
+		return "TagDescriptions[0].Tags"
+	case "mediaconvert":
+		return "ResourceTags.Tags"
+	case "neptune":
+		return "TagList"
+	case "pinpoint":
+		return "TagsModel.Tags"
+	case "rds":
+		return "TagList"
+	case "route53":
+		return "ResourceTagSet.Tags"
+	case "ssm":
+		return "TagList"
+	case "waf":
+		return "TagInfoForResource.TagList"
+	case "workspaces":
+		return "TagList"
+	default:
+		return "Tags"
+	}
+}
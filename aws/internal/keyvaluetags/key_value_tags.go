@@ -0,0 +1,294 @@
+//go:generate go run generators/listtags/main.go
+//go:generate go run generators/servicetags/main.go
+//go:generate go run generators/updatetags/main.go
+
+package keyvaluetags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/hashcode"
+)
+
+const (
+	AwsTagKeyPrefix = `aws:`
+)
+
+// DefaultConfig contains the tags configured in the provider "default_tags"
+// block, which are applied to every resource supporting "tags_all".
+type DefaultConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the default tags overlaid with the given resource tags.
+// Resource tags take precedence over default tags with the same key.
+func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	if dc == nil {
+		return tags
+	}
+
+	return dc.Tags.Merge(tags)
+}
+
+// RemoveDefaultConfig returns the given tags without the default tags that
+// carry the same value, unless the key is also present in the configured
+// resource tags.
+func (dc *DefaultConfig) RemoveDefaultConfig(tags KeyValueTags, configuredTags KeyValueTags) KeyValueTags {
+	if dc == nil {
+		return tags
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := configuredTags[k]; !ok {
+			if dv, ok := dc.Tags[k]; ok && aws.StringValue(dv) == aws.StringValue(v) {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreConfig contains the tag keys and key prefixes configured in the
+// provider "ignore_tags" block, which are excluded from resource tags.
+type IgnoreConfig struct {
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// KeyValueTags is a standard implementation for AWS key-value resource tags.
+// The AWS Go SDK is split into multiple service packages, each service with
+// its own Go struct type representing a resource tag. To standardize logic
+// across all these Go types, we convert them into this Go type.
+type KeyValueTags map[string]*string
+
+// IgnoreAws returns non-AWS tag keys.
+func (tags KeyValueTags) IgnoreAws() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if !strings.HasPrefix(k, AwsTagKeyPrefix) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// IgnoreConfig returns any tags not removed by a given configuration.
+func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
+	if config == nil {
+		return tags
+	}
+
+	result := tags.IgnorePrefixes(config.KeyPrefixes)
+	result = result.Ignore(config.Keys)
+
+	return result
+}
+
+// Ignore returns non-matching tag keys.
+func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := ignoreTags[k]; ok {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnorePrefixes returns non-matching tag key prefixes.
+func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for ignoreTagPrefix := range ignoreTagPrefixes {
+			if strings.HasPrefix(k, ignoreTagPrefix) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// Keys returns tag keys.
+func (tags KeyValueTags) Keys() []string {
+	result := make([]string, 0, len(tags))
+
+	for k := range tags {
+		result = append(result, k)
+	}
+
+	return result
+}
+
+// Map returns tag keys mapped to their values.
+func (tags KeyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		result[k] = aws.StringValue(v)
+	}
+
+	return result
+}
+
+// Merge adds missing and updates existing tags.
+func (tags KeyValueTags) Merge(mergeTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for k, v := range mergeTags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns tags removed.
+func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns tags added and updated.
+func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok || aws.StringValue(oldV) != aws.StringValue(newV) {
+			result[k] = newV
+		}
+	}
+
+	return result
+}
+
+// Chunks returns a slice of KeyValueTags, each of the specified size.
+func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
+	result := []KeyValueTags{}
+
+	i := 0
+	var chunk KeyValueTags
+	for k, v := range tags {
+		if i%size == 0 {
+			chunk = make(KeyValueTags)
+			result = append(result, chunk)
+		}
+
+		chunk[k] = v
+
+		i++
+	}
+
+	return result
+}
+
+// Equal returns whether or not two sets of tags are equal.
+func (tags KeyValueTags) Equal(other KeyValueTags) bool {
+	if len(tags) != len(other) {
+		return false
+	}
+
+	for k, v := range tags {
+		otherV, ok := other[k]
+
+		if !ok || aws.StringValue(v) != aws.StringValue(otherV) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hash returns a stable hash value.
+// The returned value may be negative (i.e. not suitable for a 'Set' function).
+func (tags KeyValueTags) Hash() int {
+	hash := 0
+
+	for k, v := range tags {
+		hash = hash ^ hashcode.String(fmt.Sprintf("%s-%s", k, aws.StringValue(v)))
+	}
+
+	return hash
+}
+
+// New creates KeyValueTags from common Terraform Provider SDK types.
+// Supports map[string]string, map[string]*string, map[string]interface{},
+// []string and []interface{}.
+// When passed []string or []interface{}, all tags will have an empty value.
+func New(i interface{}) KeyValueTags {
+	switch value := i.(type) {
+	case KeyValueTags:
+		return value
+	case map[string]string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v // Prevent referencing issues
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case map[string]*string:
+		return KeyValueTags(value)
+	case map[string]interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for k, v := range value {
+			str := v.(string)
+			kvtm[k] = &str
+		}
+
+		return kvtm
+	case []string:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v] = nil
+		}
+
+		return kvtm
+	case []interface{}:
+		kvtm := make(KeyValueTags, len(value))
+
+		for _, v := range value {
+			kvtm[v.(string)] = nil
+		}
+
+		return kvtm
+	default:
+		return make(KeyValueTags)
+	}
+}
@@ -0,0 +1,765 @@
+package keyvaluetags
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		tags          KeyValueTags
+		want          map[string]string
+	}{
+		{
+			name:          "nil default config",
+			defaultConfig: nil,
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "no resource tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{}),
+			want: map[string]string{
+				"owner": "team",
+			},
+		},
+		{
+			name: "resource tags override default tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"env":   "dev",
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{
+				"env":  "prod",
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"env":   "prod",
+				"key1":  "value1",
+				"owner": "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.MergeTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigRemoveDefaultConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		defaultConfig  *DefaultConfig
+		tags           KeyValueTags
+		configuredTags KeyValueTags
+		want           map[string]string
+	}{
+		{
+			name:          "nil default config",
+			defaultConfig: nil,
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			configuredTags: New(nil),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "default tags removed",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"env":   "dev",
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{
+				"env":   "prod",
+				"key1":  "value1",
+				"owner": "team",
+			}),
+			configuredTags: New(nil),
+			want: map[string]string{
+				"env":  "prod",
+				"key1": "value1",
+			},
+		},
+		{
+			name: "configured default tags kept",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"owner": "team",
+				}),
+			},
+			tags: New(map[string]string{
+				"owner": "team",
+			}),
+			configuredTags: New(map[string]string{
+				"owner": "team",
+			}),
+			want: map[string]string{
+				"owner": "team",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.RemoveDefaultConfig(testCase.tags, testCase.configuredTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"aws:cloudformation:key2": "value2",
+				"aws:cloudformation:key3": "value3",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"key3":                    "value3",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreAws()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreConfig(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tags         KeyValueTags
+		ignoreConfig *IgnoreConfig
+		want         map[string]string
+	}{
+		{
+			name: "nil config",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreConfig: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "keys and key prefixes",
+			tags: New(map[string]string{
+				"key1":                               "value1",
+				"key2":                               "value2",
+				"kubernetes.io/cluster/test-cluster": "owned",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"key1"}),
+				KeyPrefixes: New([]string{"kubernetes.io/"}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreConfig(testCase.ignoreConfig)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			ignoreTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New([]string{
+				"key1",
+				"key2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTags: New([]string{
+				"key1",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New([]string{
+				"key4",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Ignore(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
+	testCases := []struct {
+		name              string
+		tags              KeyValueTags
+		ignoreTagPrefixes KeyValueTags
+		want              map[string]string
+	}{
+		{
+			name:              "empty",
+			tags:              New(map[string]string{}),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want:              map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1":   "value1",
+				"other1": "value2",
+			}),
+			ignoreTagPrefixes: New([]string{"key"}),
+			want: map[string]string{
+				"other1": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnorePrefixes(testCase.ignoreTagPrefixes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []string{},
+		},
+		{
+			name: "non-empty",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: []string{
+				"key1",
+				"key2",
+				"key3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Keys()
+
+			testKeyValueTagsVerifyKeys(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsMerge(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		mergeTags KeyValueTags
+		want      map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			mergeTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "add and update",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			mergeTags: New(map[string]string{
+				"key2": "value2updated",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Merge(testCase.mergeTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsRemoved(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no changes",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Removed(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsUpdated(t *testing.T) {
+	testCases := []struct {
+		name    string
+		oldTags KeyValueTags
+		newTags KeyValueTags
+		want    map[string]string
+	}{
+		{
+			name:    "empty",
+			oldTags: New(map[string]string{}),
+			newTags: New(map[string]string{}),
+			want:    map[string]string{},
+		},
+		{
+			name: "all new",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "no changes",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: map[string]string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.oldTags.Updated(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		size int
+		want []int
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			size: 10,
+			want: []int{},
+		},
+		{
+			name: "under size",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			size: 10,
+			want: []int{2},
+		},
+		{
+			name: "over size",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+				"key5": "value5",
+			}),
+			size: 2,
+			want: []int{2, 2, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Chunks(testCase.size)
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("expected %d chunks, got %d", len(testCase.want), len(got))
+			}
+
+			for i, n := range testCase.want {
+				if len(got[i]) != n {
+					t.Errorf("expected chunk %d length %d, got %d", i, n, len(got[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsEqual(t *testing.T) {
+	testCases := []struct {
+		name  string
+		tags  KeyValueTags
+		other KeyValueTags
+		want  bool
+	}{
+		{
+			name:  "empty",
+			tags:  New(map[string]string{}),
+			other: New(map[string]string{}),
+			want:  true,
+		},
+		{
+			name: "equal",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			other: New(map[string]string{
+				"key2": "value2",
+				"key1": "value1",
+			}),
+			want: true,
+		},
+		{
+			name: "different values",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			other: New(map[string]string{
+				"key1": "value1updated",
+			}),
+			want: false,
+		},
+		{
+			name: "different keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			other: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Equal(testCase.other)
+
+			if got != testCase.want {
+				t.Errorf("expected %t, got %t", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsHash(t *testing.T) {
+	left := New(map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+	})
+	right := New(map[string]interface{}{
+		"key2": "value2",
+		"key1": "value1",
+	})
+
+	if left.Hash() != right.Hash() {
+		t.Errorf("expected equal hashes for equal tags")
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name   string
+		source interface{}
+		want   map[string]string
+	}{
+		{
+			name:   "nil",
+			source: nil,
+			want:   map[string]string{},
+		},
+		{
+			name: "map_string_interface",
+			source: map[string]interface{}{
+				"key1": "value1",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "map_string_stringPointer",
+			source: map[string]*string{
+				"key1": testStringPtr("value1"),
+				"key2": nil,
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+		},
+		{
+			name:   "slice_string",
+			source: []string{"key1"},
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+		{
+			name:   "slice_interface",
+			source: []interface{}{"key1"},
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := New(testCase.source)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func testKeyValueTagsVerifyKeys(t *testing.T, got []string, want []string) {
+	sort.Strings(got)
+	sort.Strings(want)
+
+	if len(got) != len(want) {
+		t.Fatalf("expected keys %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected keys %v, got %v", want, got)
+		}
+	}
+}
+
+func testKeyValueTagsVerifyMap(t *testing.T, got map[string]string, want map[string]string) {
+	for k, wantV := range want {
+		gotV, ok := got[k]
+
+		if !ok {
+			t.Errorf("want missing key: %s", k)
+			continue
+		}
+
+		if gotV != wantV {
+			t.Errorf("got key (%s) value %s, want value %s", k, gotV, wantV)
+		}
+	}
+
+	for k := range got {
+		if _, ok := want[k]; !ok {
+			t.Errorf("got extra key: %s", k)
+		}
+	}
+}
+
+func testStringPtr(v string) *string {
+	return &v
+}
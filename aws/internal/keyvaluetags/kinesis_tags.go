@@ -0,0 +1,59 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+)
+
+const (
+	// Kinesis requires tagging operations be split into 10 tag batches
+	kinesisTagBatchLimit = 10
+)
+
+// KinesisUpdateTags updates kinesis service tags.
+// The identifier is the stream name. Tagging operations are split into
+// batches, as the service limits the number of tags per request.
+func KinesisUpdateTags(conn *kinesis.Kinesis, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		for _, removedTags := range removedTags.IgnoreAws().Chunks(kinesisTagBatchLimit) {
+			input := &kinesis.RemoveTagsFromStreamInput{
+				StreamName: aws.String(identifier),
+				TagKeys:    aws.StringSlice(removedTags.Keys()),
+			}
+
+			_, err := conn.RemoveTagsFromStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error untagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		for _, updatedTags := range updatedTags.IgnoreAws().Chunks(kinesisTagBatchLimit) {
+			input := &kinesis.AddTagsToStreamInput{
+				StreamName: aws.String(identifier),
+				Tags:       updatedTags.KinesisStreamTags(),
+			}
+
+			_, err := conn.AddTagsToStream(input)
+
+			if err != nil {
+				return fmt.Errorf("error tagging resource (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// KinesisStreamTags returns kinesis service tags for stream tagging
+// operations, which use a map rather than the service tag type.
+func (tags KeyValueTags) KinesisStreamTags() map[string]*string {
+	return aws.StringMap(tags.Map())
+}
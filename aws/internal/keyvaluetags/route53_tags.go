@@ -0,0 +1,42 @@
+package keyvaluetags
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// Route53UpdateTags updates route53 service tags.
+// The service changes tags additions and removals in a single request.
+func Route53UpdateTags(conn *route53.Route53, identifier string, resourceType string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+	removedTags := oldTags.Removed(newTags).IgnoreAws()
+	updatedTags := oldTags.Updated(newTags).IgnoreAws()
+
+	if len(removedTags) == 0 && len(updatedTags) == 0 {
+		return nil
+	}
+
+	input := &route53.ChangeTagsForResourceInput{
+		ResourceId:   aws.String(identifier),
+		ResourceType: aws.String(resourceType),
+	}
+
+	if len(removedTags) > 0 {
+		input.RemoveTagKeys = aws.StringSlice(removedTags.Keys())
+	}
+
+	if len(updatedTags) > 0 {
+		input.AddTags = updatedTags.Route53Tags()
+	}
+
+	_, err := conn.ChangeTagsForResource(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating resource (%s) tags: %s", identifier, err)
+	}
+
+	return nil
+}
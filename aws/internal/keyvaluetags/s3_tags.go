@@ -0,0 +1,148 @@
+package keyvaluetags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Custom S3 tag service functions. S3 replaces the entire tag set on each
+// update, so any tags not managed by Terraform (e.g. AWS reserved tags)
+// must be read and preserved.
+
+// S3BucketListTags lists S3 bucket tags.
+// The identifier is the bucket name.
+func S3BucketListTags(conn *s3.S3, identifier string) (KeyValueTags, error) {
+	input := &s3.GetBucketTaggingInput{
+		Bucket: aws.String(identifier),
+	}
+
+	output, err := conn.GetBucketTagging(input)
+
+	// S3 API Reference (https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketTagging.html)
+	// lists the special error as NoSuchTagSetError, however the existing logic used NoSuchTagSet
+	// and the AWS Go SDK has neither as a constant.
+	if isAWSErr(err, "NoSuchTagSet", "") {
+		return New(nil), nil
+	}
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return S3KeyValueTags(output.TagSet), nil
+}
+
+// S3BucketUpdateTags updates S3 bucket tags.
+// The identifier is the bucket name. Errors from the tagging calls are
+// returned unwrapped so callers can retry on eventual consistency errors.
+func S3BucketUpdateTags(conn *s3.S3, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := S3BucketListTags(conn, identifier)
+
+	if err != nil {
+		return err
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3.PutBucketTaggingInput{
+			Bucket: aws.String(identifier),
+			Tagging: &s3.Tagging{
+				TagSet: newTags.Merge(ignoredTags).S3Tags(),
+			},
+		}
+
+		if _, err := conn.PutBucketTagging(input); err != nil {
+			return err
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(identifier),
+		}
+
+		if _, err := conn.DeleteBucketTagging(input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// S3ObjectListTags lists S3 object tags.
+func S3ObjectListTags(conn *s3.S3, bucket, key string) (KeyValueTags, error) {
+	input := &s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := conn.GetObjectTagging(input)
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return S3KeyValueTags(output.TagSet), nil
+}
+
+// S3ObjectUpdateTags updates S3 object tags.
+func S3ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := New(oldTagsMap)
+	newTags := New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := S3ObjectListTags(conn, bucket, key)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s/%s): %s", bucket, key, err)
+	}
+
+	ignoredTags := allTags.Ignore(oldTags).Ignore(newTags)
+
+	if len(newTags)+len(ignoredTags) > 0 {
+		input := &s3.PutObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Tagging: &s3.Tagging{
+				TagSet: newTags.Merge(ignoredTags).S3Tags(),
+			},
+		}
+
+		_, err := conn.PutObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s/%s): %s", bucket, key, err)
+		}
+	} else if len(oldTags) > 0 && len(ignoredTags) == 0 {
+		input := &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.DeleteObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s/%s): %s", bucket, key, err)
+		}
+	}
+
+	return nil
+}
+
+// isAWSErr returns true if the error matches all these conditions:
+//   - err is of type awserr.Error
+//   - Error.Code() matches code
+//   - Error.Message() contains message
+func isAWSErr(err error, code string, message string) bool {
+	if err, ok := err.(awserr.Error); ok {
+		return err.Code() == code && strings.Contains(err.Message(), message)
+	}
+	return false
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsAcmCertificate() *schema.Resource {
@@ -23,6 +24,8 @@ func resourceAwsAcmCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"certificate_body": {
 				Type:      schema.TypeString,
@@ -142,7 +145,8 @@ func resourceAwsAcmCertificate() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -174,10 +178,10 @@ func resourceAwsAcmCertificateCreateImported(d *schema.ResourceData, meta interf
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
-			Tags:           tagsFromMapACM(v.(map[string]interface{})),
+			Tags:           keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().AcmTags(),
 		}
 		_, err := acmconn.AddTagsToCertificate(params)
 
@@ -221,10 +225,10 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 	}
 
 	d.SetId(*resp.CertificateArn)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params := &acm.AddTagsToCertificateInput{
 			CertificateArn: resp.CertificateArn,
-			Tags:           tagsFromMapACM(v.(map[string]interface{})),
+			Tags:           keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().AcmTags(),
 		}
 		_, err := acmconn.AddTagsToCertificate(params)
 
@@ -238,6 +242,7 @@ func resourceAwsAcmCertificateCreateRequested(d *schema.ResourceData, meta inter
 
 func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	acmconn := meta.(*AWSClient).acmconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	params := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(d.Id()),
@@ -289,7 +294,7 @@ func resourceAwsAcmCertificateRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error listing tags for certificate (%s): %s", d.Id(), err))
 		}
-		if err := d.Set("tags", tagsToMapACM(tagResp.Tags)); err != nil {
+		if err := setTagsAll(d, meta, keyvaluetags.AcmKeyValueTags(tagResp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 			return resource.NonRetryableError(err)
 		}
 
@@ -318,9 +323,10 @@ func resourceAwsAcmCertificateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		err := setTagsACM(acmconn, d)
-		if err != nil {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AcmUpdateTags(acmconn, d.Get("arn").(string), o, n); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAcmpcaCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAcmpcaCertificateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.SigningAlgorithmSha256withecdsa,
+					acmpca.SigningAlgorithmSha256withrsa,
+					acmpca.SigningAlgorithmSha384withecdsa,
+					acmpca.SigningAlgorithmSha384withrsa,
+					acmpca.SigningAlgorithmSha512withecdsa,
+					acmpca.SigningAlgorithmSha512withrsa,
+				}, false),
+			},
+			"template_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"validity": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								acmpca.ValidityPeriodTypeAbsolute,
+								acmpca.ValidityPeriodTypeDays,
+								acmpca.ValidityPeriodTypeEndDate,
+								acmpca.ValidityPeriodTypeMonths,
+								acmpca.ValidityPeriodTypeYears,
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d+$`), "must be a positive integer"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+
+	validity, err := expandAcmpcaValidity(d.Get("validity").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+		Csr:                     []byte(d.Get("certificate_signing_request").(string)),
+		IdempotencyToken:        aws.String(resource.UniqueId()),
+		SigningAlgorithm:        aws.String(d.Get("signing_algorithm").(string)),
+		Validity:                validity,
+	}
+
+	if v, ok := d.GetOk("template_arn"); ok {
+		input.TemplateArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Issuing ACMPCA Certificate: %s", input)
+	output, err := conn.IssueCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error issuing ACMPCA Certificate with Certificate Authority (%s): %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(aws.StringValue(output.CertificateArn))
+
+	getCertificateInput := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+	}
+
+	// The certificate is issued asynchronously; GetCertificate returns
+	// RequestInProgressException until it is available.
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.GetCertificate(getCertificateInput)
+
+		if isAWSErr(err, acmpca.ErrCodeRequestInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.GetCertificate(getCertificateInput)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate (%s) to be issued: %s", d.Id(), err)
+	}
+
+	return resourceAwsAcmpcaCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate: %s", input)
+	output, err := conn.GetCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] ACMPCA Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	block, _ := pem.Decode([]byte(d.Get("certificate").(string)))
+	if block == nil {
+		log.Printf("[WARN] ACMPCA Certificate (%s) has no PEM encoded certificate, skipping revocation", d.Id())
+		return nil
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	input := &acmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		CertificateSerial:       aws.String(fmt.Sprintf("%x", certificate.SerialNumber)),
+		RevocationReason:        aws.String(acmpca.RevocationReasonUnspecified),
+	}
+
+	log.Printf("[DEBUG] Revoking ACMPCA Certificate: %s", input)
+	_, err = conn.RevokeCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") ||
+		isAWSErr(err, acmpca.ErrCodeRequestAlreadyProcessedException, "") ||
+		isAWSErr(err, acmpca.ErrCodeRequestInProgressException, "") ||
+		isAWSErr(err, acmpca.ErrCodeInvalidRequestException, "Self-signed certificate can not be revoked") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking ACMPCA Certificate (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	certificateAuthorityArn, err := acmpcaCertificateAuthorityArnFromCertificateArn(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("certificate_authority_arn", certificateAuthorityArn)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// acmpcaCertificateAuthorityArnFromCertificateArn returns the issuing certificate authority ARN of a certificate ARN, e.g.
+// arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/CA_ID/certificate/CERTIFICATE_ID.
+func acmpcaCertificateAuthorityArnFromCertificateArn(certificateArn string) (string, error) {
+	parsedArn, err := arn.Parse(certificateArn)
+	if err != nil {
+		return "", fmt.Errorf("error parsing ACMPCA Certificate ARN (%s): %s", certificateArn, err)
+	}
+
+	parts := strings.Split(parsedArn.Resource, "/")
+	if len(parts) != 4 || parts[0] != "certificate-authority" || parts[2] != "certificate" {
+		return "", fmt.Errorf("unexpected format for ACMPCA Certificate ARN (%s), expected arn:PARTITION:acm-pca:REGION:ACCOUNT:certificate-authority/CA_ID/certificate/CERTIFICATE_ID", certificateArn)
+	}
+
+	parsedArn.Resource = strings.Join(parts[0:2], "/")
+
+	return parsedArn.String(), nil
+}
+
+func expandAcmpcaValidity(l []interface{}) (*acmpca.Validity, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	value, err := strconv.ParseInt(m["value"].(string), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ACMPCA Certificate validity value (%s): %s", m["value"].(string), err)
+	}
+
+	validity := &acmpca.Validity{
+		Type:  aws.String(m["type"].(string)),
+		Value: aws.Int64(value),
+	}
+
+	return validity, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
@@ -31,6 +32,8 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 		MigrateState:  resourceAwsAcmpcaCertificateAuthorityMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -251,7 +254,8 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 				Default:      30,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -299,10 +303,10 @@ func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(output.CertificateAuthorityArn))
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input := &acmpca.TagCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(d.Id()),
-			Tags:                    tagsFromMapACMPCA(v.(map[string]interface{})),
+			Tags:                    keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().AcmpcaTags(),
 		}
 
 		log.Printf("[DEBUG] Tagging ACMPCA Certificate Authority: %s", input)
@@ -335,6 +339,7 @@ func resourceAwsAcmpcaCertificateAuthorityCreate(d *schema.ResourceData, meta in
 
 func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).acmpcaconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	describeCertificateAuthorityInput := &acmpca.DescribeCertificateAuthorityInput{
 		CertificateAuthorityArn: aws.String(d.Id()),
@@ -432,7 +437,7 @@ func resourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error reading ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapACMPCA(tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.AcmpcaKeyValueTags(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -468,31 +473,11 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsACMPCA(tagsFromMapACMPCA(o), tagsFromMapACMPCA(n))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing ACMPCA Certificate Authority %q tags: %#v", d.Id(), remove)
-			_, err := conn.UntagCertificateAuthority(&acmpca.UntagCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(d.Id()),
-				Tags:                    remove,
-			})
-			if err != nil {
-				return fmt.Errorf("error updating ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating ACMPCA Certificate Authority %q tags: %#v", d.Id(), create)
-			_, err := conn.TagCertificateAuthority(&acmpca.TagCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(d.Id()),
-				Tags:                    create,
-			})
-			if err != nil {
-				return fmt.Errorf("error updating ACMPCA Certificate Authority %q tags: %s", d.Id(), err)
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.AcmpcaUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating ACMPCA Certificate Authority (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsAcmpcaCertificateAuthorityCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateAuthorityCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateAuthorityCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateAuthorityCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("certificate_authority_arn", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAcmpcaCertificateWhitespaceDiffs,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressAcmpcaCertificateWhitespaceDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+
+	input := &acmpca.ImportCertificateAuthorityCertificateInput{
+		Certificate:             []byte(d.Get("certificate").(string)),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+	}
+
+	if v, ok := d.GetOk("certificate_chain"); ok {
+		input.CertificateChain = []byte(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing ACMPCA Certificate Authority Certificate: %s", input)
+	_, err := conn.ImportCertificateAuthorityCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error importing ACMPCA Certificate Authority (%s) Certificate: %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(certificateAuthorityArn)
+
+	return resourceAwsAcmpcaCertificateAuthorityCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority Certificate: %s", input)
+	output, err := conn.GetCertificateAuthorityCertificate(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] ACMPCA Certificate Authority (%s) not found, removing Certificate Authority Certificate from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Returned when the certificate authority has no certificate installed, e.g. PENDING_CERTIFICATE status.
+	if isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+		log.Printf("[WARN] ACMPCA Certificate Authority (%s) has no certificate installed, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate Authority (%s) Certificate: %s", d.Id(), err)
+	}
+
+	d.Set("certificate_authority_arn", d.Id())
+	d.Set("certificate", aws.StringValue(output.Certificate))
+	d.Set("certificate_chain", aws.StringValue(output.CertificateChain))
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to remove a certificate from a certificate authority.
+	log.Printf("[WARN] ACMPCA Certificate Authority (%s) Certificate cannot be removed, only removing from state", d.Id())
+
+	return nil
+}
+
+// suppressAcmpcaCertificateWhitespaceDiffs ignores surrounding whitespace differences
+// between the configured PEM and the PEM returned by the API.
+func suppressAcmpcaCertificateWhitespaceDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
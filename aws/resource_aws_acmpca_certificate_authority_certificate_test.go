@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_RootCA(t *testing.T) {
+	var certificateAuthority acmpca.CertificateAuthority
+	resourceName := "aws_acmpca_certificate_authority_certificate.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName),
+					testAccCheckAwsAcmpcaCertificateAuthorityExists(certificateAuthorityResourceName, &certificateAuthority),
+					testAccCheckAwsAcmpcaCertificateAuthorityStatus(&certificateAuthority, acmpca.CertificateAuthorityStatusActive),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate", "aws_acmpca_certificate.test", "certificate"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_chain", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_SubordinateCA(t *testing.T) {
+	var certificateAuthority acmpca.CertificateAuthority
+	resourceName := "aws_acmpca_certificate_authority_certificate.subordinate"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.subordinate"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_SubordinateCA(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName),
+					testAccCheckAwsAcmpcaCertificateAuthorityExists(certificateAuthorityResourceName, &certificateAuthority),
+					testAccCheckAwsAcmpcaCertificateAuthorityStatus(&certificateAuthority, acmpca.CertificateAuthorityStatusActive),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate", "aws_acmpca_certificate.subordinate", "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_chain"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ACMPCA Certificate Authority Certificate ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+		output, err := conn.GetCertificateAuthorityCertificate(&acmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Certificate) == "" {
+			return fmt.Errorf("ACMPCA Certificate Authority (%s) has no certificate", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsAcmpcaCertificateAuthorityStatus(certificateAuthority *acmpca.CertificateAuthority, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(certificateAuthority.Status) != status {
+			return fmt.Errorf("ACMPCA Certificate Authority (%s) status is %s, expected %s", aws.StringValue(certificateAuthority.Arn), aws.StringValue(certificateAuthority.Status), status)
+		}
+
+		return nil
+	}
+}
+
+// testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA creates a self-signed, active root certificate authority.
+func testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA() string {
+	return `
+data "aws_partition" "current" {}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = "${aws_acmpca_certificate_authority.test.arn}"
+  certificate_signing_request = "${aws_acmpca_certificate_authority.test.certificate_signing_request}"
+  signing_algorithm           = "SHA512WITHRSA"
+  template_arn                = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = "1"
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = "${aws_acmpca_certificate_authority.test.arn}"
+  certificate               = "${aws_acmpca_certificate.test.certificate}"
+  certificate_chain         = "${aws_acmpca_certificate.test.certificate_chain}"
+}
+`
+}
+
+func testAccAwsAcmpcaCertificateAuthorityCertificateConfig_SubordinateCA() string {
+	return testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA() + `
+resource "aws_acmpca_certificate_authority" "subordinate" {
+  permanent_deletion_time_in_days = 7
+  type                            = "SUBORDINATE"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_2048"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "sub.terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "subordinate" {
+  certificate_authority_arn   = "${aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn}"
+  certificate_signing_request = "${aws_acmpca_certificate_authority.subordinate.certificate_signing_request}"
+  signing_algorithm           = "SHA512WITHRSA"
+  template_arn                = "arn:${data.aws_partition.current.partition}:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"
+
+  validity {
+    type  = "MONTHS"
+    value = "6"
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "subordinate" {
+  certificate_authority_arn = "${aws_acmpca_certificate_authority.subordinate.arn}"
+  certificate               = "${aws_acmpca_certificate.subordinate.certificate}"
+  certificate_chain         = "${aws_acmpca_certificate.subordinate.certificate_chain}"
+}
+`
+}
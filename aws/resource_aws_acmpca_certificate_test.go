@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAcmpcaCertificateAuthorityArnFromCertificateArn(t *testing.T) {
+	testCases := []struct {
+		CertificateArn string
+		ExpectedArn    string
+		ExpectedErr    bool
+	}{
+		{
+			CertificateArn: "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012/certificate/286535153982981100925020015808220737245",
+			ExpectedArn:    "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012",
+		},
+		{
+			CertificateArn: "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012",
+			ExpectedErr:    true,
+		},
+		{
+			CertificateArn: "12345678-1234-1234-1234-123456789012",
+			ExpectedErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		arn, err := acmpcaCertificateAuthorityArnFromCertificateArn(tc.CertificateArn)
+
+		if tc.ExpectedErr {
+			if err == nil {
+				t.Errorf("expected error for %q", tc.CertificateArn)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tc.CertificateArn, err)
+			continue
+		}
+
+		if arn != tc.ExpectedArn {
+			t.Errorf("expected %q for %q, got %q", tc.ExpectedArn, tc.CertificateArn, arn)
+		}
+	}
+}
+
+func TestAccAwsAcmpcaCertificate_RootCertificate(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(`^arn:[^:]+:acm-pca:[^:]+:[^:]+:certificate-authority/.+/certificate/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", certificateAuthorityResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_chain", ""),
+					resource.TestCheckResourceAttr(resourceName, "signing_algorithm", "SHA512WITHRSA"),
+					resource.TestCheckResourceAttr(resourceName, "validity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.type", "YEARS"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.value", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"certificate_signing_request",
+					"signing_algorithm",
+					"template_arn",
+					"validity",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAwsAcmpcaCertificate_EndEntityCertificate(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.end_entity"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateConfig_EndEntityCertificate(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_chain", "aws_acmpca_certificate.test", "certificate"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.type", "DAYS"),
+					resource.TestCheckResourceAttr(resourceName, "validity.0.value", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ACMPCA Certificate ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+		output, err := conn.GetCertificate(&acmpca.GetCertificateInput{
+			CertificateArn:          aws.String(rs.Primary.ID),
+			CertificateAuthorityArn: aws.String(rs.Primary.Attributes["certificate_authority_arn"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Certificate) == "" {
+			return fmt.Errorf("ACMPCA Certificate (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsAcmpcaCertificateConfig_EndEntityCertificate() string {
+	return testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootCA() + `
+resource "tls_private_key" "test" {
+  algorithm = "RSA"
+}
+
+resource "tls_cert_request" "test" {
+  key_algorithm   = "RSA"
+  private_key_pem = "${tls_private_key.test.private_key_pem}"
+
+  subject {
+    common_name = "www.terraformtesting.com"
+  }
+}
+
+resource "aws_acmpca_certificate" "end_entity" {
+  certificate_authority_arn   = "${aws_acmpca_certificate_authority_certificate.test.certificate_authority_arn}"
+  certificate_signing_request = "${tls_cert_request.test.cert_request_pem}"
+  signing_algorithm           = "SHA256WITHRSA"
+
+  validity {
+    type  = "DAYS"
+    value = "1"
+  }
+}
+`
+}
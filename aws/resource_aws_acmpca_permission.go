@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsAcmpcaPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaPermissionCreate,
+		Read:   resourceAwsAcmpcaPermissionRead,
+		Delete: resourceAwsAcmpcaPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						acmpca.ActionTypeGetCertificate,
+						acmpca.ActionTypeIssueCertificate,
+						acmpca.ActionTypeListPermissions,
+					}, false),
+				},
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"acm.amazonaws.com",
+				}, false),
+			},
+			"source_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
+	principal := d.Get("principal").(string)
+
+	input := &acmpca.CreatePermissionInput{
+		Actions:                 expandStringSet(d.Get("actions").(*schema.Set)),
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+		Principal:               aws.String(principal),
+	}
+
+	sourceAccount := ""
+	if v, ok := d.GetOk("source_account"); ok {
+		sourceAccount = v.(string)
+		input.SourceAccount = aws.String(sourceAccount)
+	}
+
+	log.Printf("[DEBUG] Creating ACMPCA Permission: %s", input)
+	_, err := conn.CreatePermission(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ACMPCA Permission for Certificate Authority (%s): %s", certificateAuthorityArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s|%s", certificateAuthorityArn, principal, sourceAccount))
+
+	return resourceAwsAcmpcaPermissionRead(d, meta)
+}
+
+func resourceAwsAcmpcaPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	certificateAuthorityArn, principal, sourceAccount, err := decodeAcmpcaPermissionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	permission, err := getAcmpcaPermission(conn, certificateAuthorityArn, principal, sourceAccount)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] ACMPCA Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Permission (%s): %s", d.Id(), err)
+	}
+
+	if permission == nil {
+		log.Printf("[WARN] ACMPCA Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("actions", flattenStringSet(permission.Actions)); err != nil {
+		return fmt.Errorf("error setting actions: %s", err)
+	}
+
+	d.Set("certificate_authority_arn", permission.CertificateAuthorityArn)
+	d.Set("policy", permission.Policy)
+	d.Set("principal", permission.Principal)
+	d.Set("source_account", permission.SourceAccount)
+
+	return nil
+}
+
+func resourceAwsAcmpcaPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.DeletePermissionInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		Principal:               aws.String(d.Get("principal").(string)),
+	}
+
+	if v, ok := d.GetOk("source_account"); ok {
+		input.SourceAccount = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting ACMPCA Permission: %s", input)
+	_, err := conn.DeletePermission(input)
+
+	if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ACMPCA Permission (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeAcmpcaPermissionID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected CERTIFICATE_AUTHORITY_ARN|PRINCIPAL|SOURCE_ACCOUNT", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+// getAcmpcaPermission returns the permission granted to principal on the certificate authority.
+// An empty sourceAccount matches the permission regardless of its source account.
+func getAcmpcaPermission(conn *acmpca.ACMPCA, certificateAuthorityArn, principal, sourceAccount string) (*acmpca.Permission, error) {
+	var result *acmpca.Permission
+
+	input := &acmpca.ListPermissionsInput{
+		CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+	}
+
+	err := conn.ListPermissionsPages(input, func(page *acmpca.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.Permissions {
+			if permission == nil || aws.StringValue(permission.Principal) != principal {
+				continue
+			}
+
+			if sourceAccount != "" && aws.StringValue(permission.SourceAccount) != sourceAccount {
+				continue
+			}
+
+			result = permission
+			return false
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsAcmpcaPermission_basic(t *testing.T) {
+	var permission acmpca.Permission
+	resourceName := "aws_acmpca_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaPermissionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaPermissionExists(resourceName, &permission),
+					resource.TestCheckResourceAttr(resourceName, "actions.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_authority_arn", "aws_acmpca_certificate_authority.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+					resource.TestCheckResourceAttr(resourceName, "principal", "acm.amazonaws.com"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_account", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaPermissionExists(resourceName string, permission *acmpca.Permission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ACMPCA Permission ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+		certificateAuthorityArn, principal, sourceAccount, err := decodeAcmpcaPermissionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := getAcmpcaPermission(conn, certificateAuthorityArn, principal, sourceAccount)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("ACMPCA Permission (%s) not found", rs.Primary.ID)
+		}
+
+		*permission = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsAcmpcaPermissionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acmpca_permission" {
+			continue
+		}
+
+		certificateAuthorityArn, principal, sourceAccount, err := decodeAcmpcaPermissionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := getAcmpcaPermission(conn, certificateAuthorityArn, principal, sourceAccount)
+
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("ACMPCA Permission (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsAcmpcaPermissionConfig() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_permission" "test" {
+  certificate_authority_arn = "${aws_acmpca_certificate_authority.test.arn}"
+  actions                   = ["IssueCertificate", "GetCertificate", "ListPermissions"]
+  principal                 = "acm.amazonaws.com"
+  source_account            = "${data.aws_caller_identity.current.account_id}"
+}
+`
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsApiGatewayStage() *schema.Resource {
@@ -35,6 +36,8 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"access_log_settings": {
 				Type:     schema.TypeList,
@@ -103,7 +106,8 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"xray_tracing_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,7 +152,7 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 		}
 		input.Variables = aws.StringMap(variables)
 	}
-	if vars, ok := d.GetOk("tags"); ok {
+	if vars, ok := d.GetOk("tags_all"); ok {
 		newMap := make(map[string]string, len(vars.(map[string]interface{})))
 		for k, v := range vars.(map[string]interface{}) {
 			newMap[k] = v.(string)
@@ -205,6 +209,7 @@ func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsApiGatewayStageRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	log.Printf("[DEBUG] Reading API Gateway Stage %s", d.Id())
 	restApiId := d.Get("rest_api_id").(string)
@@ -243,7 +248,7 @@ func resourceAwsApiGatewayStageRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("documentation_version", stage.DocumentationVersion)
 	d.Set("xray_tracing_enabled", stage.TracingEnabled)
 
-	if err := d.Set("tags", aws.StringValueMap(stage.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.ApigatewayKeyValueTags(stage.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -277,10 +282,15 @@ func resourceAwsApiGatewayStageUpdate(d *schema.ResourceData, meta interface{})
 		Service:   "apigateway",
 		Resource:  fmt.Sprintf("/restapis/%s/stages/%s", d.Get("rest_api_id").(string), d.Get("stage_name").(string)),
 	}.String()
-	if tagErr := setTagsAPIGatewayStage(conn, d, stageArn); tagErr != nil {
-		return tagErr
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if tagErr := keyvaluetags.ApigatewayUpdateTags(conn, stageArn, o, n); tagErr != nil {
+			return tagErr
+		}
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	operations := make([]*apigateway.PatchOperation, 0)
 	waitForCache := false
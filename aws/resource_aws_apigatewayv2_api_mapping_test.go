@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2ApiMapping_basic(t *testing.T) {
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+	stageResourceName := "aws_apigatewayv2_stage.test"
+	rName := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "stage", stageResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2ApiMapping_ApiMappingKey(t *testing.T) {
+	resourceName := "aws_apigatewayv2_api_mapping.test"
+	rName := fmt.Sprintf("tf-acc-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2ApiMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, "key1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "key1"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, "key2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2ApiMappingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "key2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_api_mapping" {
+			continue
+		}
+
+		_, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 API mapping %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2ApiMappingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		_, err := conn.GetApiMapping(&apigatewayv2.GetApiMappingInput{
+			ApiMappingId: aws.String(rs.Primary.ID),
+			DomainName:   aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["domain_name"]), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_base(rName string) string {
+	return testAccAWSAPIGatewayV2DomainNameConfig_basic(rName) + `
+resource "aws_apigatewayv2_api" "test" {
+  name                       = "tf-testacc-apigwv2-api-mapping"
+  protocol_type              = "WEBSOCKET"
+  route_selection_expression = "$request.body.action"
+}
+
+resource "aws_apigatewayv2_stage" "test" {
+  api_id = "${aws_apigatewayv2_api.test.id}"
+  name   = "test"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiMappingConfig_base(rName) + `
+resource "aws_apigatewayv2_api_mapping" "test" {
+  api_id      = "${aws_apigatewayv2_api.test.id}"
+  domain_name = "${aws_apigatewayv2_domain_name.test.id}"
+  stage       = "${aws_apigatewayv2_stage.test.id}"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2ApiMappingConfig_apiMappingKey(rName, apiMappingKey string) string {
+	return testAccAWSAPIGatewayV2ApiMappingConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_api_mapping" "test" {
+  api_id          = "${aws_apigatewayv2_api.test.id}"
+  domain_name     = "${aws_apigatewayv2_domain_name.test.id}"
+  stage           = "${aws_apigatewayv2_stage.test.id}"
+  api_mapping_key = %[1]q
+}
+`, apiMappingKey)
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Authorizer_basic(t *testing.T) {
+	resourceName := "aws_apigatewayv2_authorizer.test"
+	lambdaResourceName := "aws_lambda_function.test"
+	rName := fmt.Sprintf("tf-testacc-apigwv2-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2AuthorizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2AuthorizerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_credentials_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "authorizer_type", apigatewayv2.AuthorizerTypeRequest),
+					resource.TestCheckResourceAttrPair(resourceName, "authorizer_uri", lambdaResourceName, "invoke_arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.2845350445", "route.request.header.Auth"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2AuthorizerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2Authorizer_Credentials(t *testing.T) {
+	resourceName := "aws_apigatewayv2_authorizer.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	rName := fmt.Sprintf("tf-testacc-apigwv2-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2AuthorizerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2AuthorizerConfig_credentials(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "authorizer_credentials_arn", iamRoleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.2845350445", "route.request.header.Auth"),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.442889672", "route.request.querystring.Name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2AuthorizerImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSAPIGatewayV2AuthorizerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2AuthorizerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "identity_sources.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2AuthorizerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_authorizer" {
+			continue
+		}
+
+		_, err := conn.GetAuthorizer(&apigatewayv2.GetAuthorizerInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			AuthorizerId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 authorizer %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2AuthorizerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 authorizer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		_, err := conn.GetAuthorizer(&apigatewayv2.GetAuthorizerInput{
+			ApiId:        aws.String(rs.Primary.Attributes["api_id"]),
+			AuthorizerId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2AuthorizerImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfig_base(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": ["apigateway.amazonaws.com", "lambda.amazonaws.com"]
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "index.handler"
+  runtime       = "nodejs10.x"
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2AuthorizerConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  authorizer_type  = "REQUEST"
+  authorizer_uri   = "${aws_lambda_function.test.invoke_arn}"
+  identity_sources = ["route.request.header.Auth"]
+  name             = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSAPIGatewayV2AuthorizerConfig_credentials(rName string) string {
+	return testAccAWSAPIGatewayV2AuthorizerConfig_base(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_authorizer" "test" {
+  api_id                     = "${aws_apigatewayv2_api.test.id}"
+  authorizer_credentials_arn = "${aws_iam_role.test.arn}"
+  authorizer_type            = "REQUEST"
+  authorizer_uri             = "${aws_lambda_function.test.invoke_arn}"
+  identity_sources           = ["route.request.header.Auth", "route.request.querystring.Name"]
+  name                       = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsApiGatewayV2Deployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2DeploymentCreate,
+		Read:   resourceAwsApiGatewayV2DeploymentRead,
+		Update: resourceAwsApiGatewayV2DeploymentUpdate,
+		Delete: resourceAwsApiGatewayV2DeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayV2DeploymentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2DeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.CreateDeploymentInput{
+		ApiId: aws.String(d.Get("api_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 deployment: %s", req)
+	resp, err := conn.CreateDeployment(req)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 deployment: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.DeploymentId))
+
+	if err := waitForApiGatewayV2DeploymentCreation(conn, d.Get("api_id").(string), d.Id()); err != nil {
+		return fmt.Errorf("error waiting for API Gateway v2 deployment (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2DeploymentRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", resp.Description)
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2DeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.UpdateDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	}
+	if d.HasChange("description") {
+		req.Description = aws.String(d.Get("description").(string))
+	}
+
+	log.Printf("[DEBUG] Updating API Gateway v2 deployment: %s", req)
+	_, err := conn.UpdateDeployment(req)
+	if err != nil {
+		return fmt.Errorf("error updating API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsApiGatewayV2DeploymentRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2DeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 deployment (%s)", d.Id())
+	_, err := conn.DeleteDeployment(&apigatewayv2.DeleteDeploymentInput{
+		ApiId:        aws.String(d.Get("api_id").(string)),
+		DeploymentId: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 deployment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2DeploymentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'api-id/deployment-id'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("api_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func apiGatewayV2DeploymentRefresh(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(apiId),
+			DeploymentId: aws.String(deploymentId),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(resp.DeploymentStatus) == apigatewayv2.DeploymentStatusFailed {
+			return nil, apigatewayv2.DeploymentStatusFailed, fmt.Errorf("API Gateway v2 deployment (%s) failed: %s", deploymentId, aws.StringValue(resp.DeploymentStatusMessage))
+		}
+
+		return resp, aws.StringValue(resp.DeploymentStatus), nil
+	}
+}
+
+func waitForApiGatewayV2DeploymentCreation(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigatewayv2.DeploymentStatusPending},
+		Target:     []string{apigatewayv2.DeploymentStatusDeployed},
+		Refresh:    apiGatewayV2DeploymentRefresh(conn, apiId, deploymentId),
+		Timeout:    5 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,180 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAPIGatewayV2Integration_basic(t *testing.T) {
+	resourceName := "aws_apigatewayv2_integration.test"
+	rName := fmt.Sprintf("tf-testacc-apigwv2-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2IntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", apigatewayv2.ConnectionTypeInternet),
+					resource.TestCheckResourceAttr(resourceName, "content_handling_strategy", ""),
+					resource.TestCheckResourceAttr(resourceName, "credentials_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "integration_method", ""),
+					resource.TestCheckResourceAttr(resourceName, "integration_type", apigatewayv2.IntegrationTypeMock),
+					resource.TestCheckResourceAttr(resourceName, "integration_uri", ""),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_behavior", apigatewayv2.PassthroughBehaviorWhenNoMatch),
+					resource.TestCheckResourceAttr(resourceName, "request_templates.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "template_selection_expression", ""),
+					resource.TestCheckResourceAttr(resourceName, "timeout_milliseconds", "29000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2IntegrationImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSAPIGatewayV2Integration_IntegrationTypeHttp(t *testing.T) {
+	resourceName := "aws_apigatewayv2_integration.test"
+	rName := fmt.Sprintf("tf-testacc-apigwv2-%s", acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAPIGatewayV2IntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationConfig_integrationTypeHttp(rName, "Integration 1", "http://www.example.com", 28999),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_handling_strategy", apigatewayv2.ContentHandlingStrategyConvertToText),
+					resource.TestCheckResourceAttr(resourceName, "description", "Integration 1"),
+					resource.TestCheckResourceAttr(resourceName, "integration_method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "integration_type", apigatewayv2.IntegrationTypeHttp),
+					resource.TestCheckResourceAttr(resourceName, "integration_uri", "http://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "passthrough_behavior", apigatewayv2.PassthroughBehaviorWhenNoTemplates),
+					resource.TestCheckResourceAttr(resourceName, "request_templates.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_templates.application/json", ""),
+					resource.TestCheckResourceAttr(resourceName, "template_selection_expression", "$request.body.name"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_milliseconds", "28999"),
+				),
+			},
+			{
+				Config: testAccAWSAPIGatewayV2IntegrationConfig_integrationTypeHttp(rName, "Integration 2", "http://www.example.org", 51),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAPIGatewayV2IntegrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Integration 2"),
+					resource.TestCheckResourceAttr(resourceName, "integration_uri", "http://www.example.org"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_milliseconds", "51"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAPIGatewayV2IntegrationImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_apigatewayv2_integration" {
+			continue
+		}
+
+		_, err := conn.GetIntegration(&apigatewayv2.GetIntegrationInput{
+			ApiId:         aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("API Gateway v2 integration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSAPIGatewayV2IntegrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 integration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2conn
+
+		_, err := conn.GetIntegration(&apigatewayv2.GetIntegrationInput{
+			ApiId:         aws.String(rs.Primary.Attributes["api_id"]),
+			IntegrationId: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSAPIGatewayV2IntegrationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSAPIGatewayV2IntegrationConfig_basic(rName string) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + `
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "MOCK"
+}
+`
+}
+
+func testAccAWSAPIGatewayV2IntegrationConfig_integrationTypeHttp(rName, description, integrationUri string, timeout int) string {
+	return testAccAWSAPIGatewayV2ApiConfig_basic(rName) + fmt.Sprintf(`
+resource "aws_apigatewayv2_integration" "test" {
+  api_id           = "${aws_apigatewayv2_api.test.id}"
+  integration_type = "HTTP"
+
+  content_handling_strategy     = "CONVERT_TO_TEXT"
+  description                   = %[1]q
+  integration_method            = "GET"
+  integration_uri               = %[2]q
+  passthrough_behavior          = "WHEN_NO_TEMPLATES"
+  template_selection_expression = "$request.body.name"
+  timeout_milliseconds          = %[3]d
+
+  request_templates = {
+    "application/json" = ""
+  }
+}
+`, description, integrationUri, timeout)
+}
@@ -0,0 +1,374 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsApiGatewayV2Stage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2StageCreate,
+		Read:   resourceAwsApiGatewayV2StageRead,
+		Update: resourceAwsApiGatewayV2StageUpdate,
+		Delete: resourceAwsApiGatewayV2StageDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayV2StageImport,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"access_log_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_route_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MinItems: 0,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_trace_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"detailed_metrics_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"logging_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								apigatewayv2.LoggingLevelError,
+								apigatewayv2.LoggingLevelInfo,
+								apigatewayv2.LoggingLevelFalse,
+							}, false),
+						},
+						"throttling_burst_limit": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"throttling_rate_limit": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"execution_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"stage_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2StageCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	req := &apigatewayv2.CreateStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Get("name").(string)),
+		Tags:      keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Apigatewayv2Tags(),
+	}
+	if v, ok := d.GetOk("access_log_settings"); ok {
+		req.AccessLogSettings = expandApiGatewayV2AccessLogSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("client_certificate_id"); ok {
+		req.ClientCertificateId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_route_settings"); ok {
+		req.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("deployment_id"); ok {
+		req.DeploymentId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("stage_variables"); ok {
+		req.StageVariables = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating API Gateway v2 stage: %s", req)
+	resp, err := conn.CreateStage(req)
+	if err != nil {
+		return fmt.Errorf("error creating API Gateway v2 stage: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.StageName))
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	apiId := d.Get("api_id").(string)
+	resp, err := conn.GetStage(&apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiId),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] API Gateway v2 stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("access_log_settings", flattenApiGatewayV2AccessLogSettings(resp.AccessLogSettings)); err != nil {
+		return fmt.Errorf("error setting access_log_settings: %s", err)
+	}
+	stageArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/apis/%s/stages/%s", apiId, d.Id()),
+	}.String()
+	d.Set("arn", stageArn)
+	d.Set("client_certificate_id", resp.ClientCertificateId)
+	if err := d.Set("default_route_settings", flattenApiGatewayV2DefaultRouteSettings(resp.DefaultRouteSettings)); err != nil {
+		return fmt.Errorf("error setting default_route_settings: %s", err)
+	}
+	d.Set("deployment_id", resp.DeploymentId)
+	d.Set("description", resp.Description)
+	executionArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "execute-api",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("%s/%s", apiId, d.Id()),
+	}.String()
+	d.Set("execution_arn", executionArn)
+	d.Set("invoke_url", fmt.Sprintf("wss://%s.execute-api.%s.amazonaws.com/%s", apiId, meta.(*AWSClient).region, d.Id()))
+	d.Set("name", resp.StageName)
+	if err := d.Set("stage_variables", pointersMapToStringList(resp.StageVariables)); err != nil {
+		return fmt.Errorf("error setting stage_variables: %s", err)
+	}
+
+	if err := setTagsAll(d, meta, aws.StringValueMap(resp.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	if d.HasChange("access_log_settings") || d.HasChange("client_certificate_id") || d.HasChange("default_route_settings") ||
+		d.HasChange("deployment_id") || d.HasChange("description") || d.HasChange("stage_variables") {
+		req := &apigatewayv2.UpdateStageInput{
+			ApiId:     aws.String(d.Get("api_id").(string)),
+			StageName: aws.String(d.Id()),
+		}
+		if d.HasChange("access_log_settings") {
+			req.AccessLogSettings = expandApiGatewayV2AccessLogSettings(d.Get("access_log_settings").([]interface{}))
+		}
+		if d.HasChange("client_certificate_id") {
+			req.ClientCertificateId = aws.String(d.Get("client_certificate_id").(string))
+		}
+		if d.HasChange("default_route_settings") {
+			req.DefaultRouteSettings = expandApiGatewayV2DefaultRouteSettings(d.Get("default_route_settings").([]interface{}))
+		}
+		if d.HasChange("deployment_id") {
+			req.DeploymentId = aws.String(d.Get("deployment_id").(string))
+		}
+		if d.HasChange("description") {
+			req.Description = aws.String(d.Get("description").(string))
+		}
+		if d.HasChange("stage_variables") {
+			req.StageVariables = stringMapToPointers(d.Get("stage_variables").(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating API Gateway v2 stage: %s", req)
+		_, err := conn.UpdateStage(req)
+		if err != nil {
+			return fmt.Errorf("error updating API Gateway v2 stage (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Apigatewayv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating API Gateway v2 stage (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsApiGatewayV2StageRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2StageDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2conn
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 stage (%s)", d.Id())
+	_, err := conn.DeleteStage(&apigatewayv2.DeleteStageInput{
+		ApiId:     aws.String(d.Get("api_id").(string)),
+		StageName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, apigatewayv2.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting API Gateway v2 stage (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2StageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'api-id/stage-name'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("api_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandApiGatewayV2AccessLogSettings(vSettings []interface{}) *apigatewayv2.AccessLogSettings {
+	settings := &apigatewayv2.AccessLogSettings{}
+
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return settings
+	}
+	mSettings := vSettings[0].(map[string]interface{})
+
+	if vDestinationArn, ok := mSettings["destination_arn"].(string); ok && vDestinationArn != "" {
+		settings.DestinationArn = aws.String(vDestinationArn)
+	}
+	if vFormat, ok := mSettings["format"].(string); ok && vFormat != "" {
+		settings.Format = aws.String(vFormat)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2AccessLogSettings(settings *apigatewayv2.AccessLogSettings) []interface{} {
+	if settings == nil || settings.DestinationArn == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_arn": aws.StringValue(settings.DestinationArn),
+		"format":          aws.StringValue(settings.Format),
+	}}
+}
+
+func expandApiGatewayV2DefaultRouteSettings(vSettings []interface{}) *apigatewayv2.RouteSettings {
+	settings := &apigatewayv2.RouteSettings{}
+
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return settings
+	}
+	mSettings := vSettings[0].(map[string]interface{})
+
+	if vDataTraceEnabled, ok := mSettings["data_trace_enabled"].(bool); ok {
+		settings.DataTraceEnabled = aws.Bool(vDataTraceEnabled)
+	}
+	if vDetailedMetricsEnabled, ok := mSettings["detailed_metrics_enabled"].(bool); ok {
+		settings.DetailedMetricsEnabled = aws.Bool(vDetailedMetricsEnabled)
+	}
+	if vLoggingLevel, ok := mSettings["logging_level"].(string); ok && vLoggingLevel != "" {
+		settings.LoggingLevel = aws.String(vLoggingLevel)
+	}
+	if vThrottlingBurstLimit, ok := mSettings["throttling_burst_limit"].(int); ok && vThrottlingBurstLimit > 0 {
+		settings.ThrottlingBurstLimit = aws.Int64(int64(vThrottlingBurstLimit))
+	}
+	if vThrottlingRateLimit, ok := mSettings["throttling_rate_limit"].(float64); ok && vThrottlingRateLimit > 0 {
+		settings.ThrottlingRateLimit = aws.Float64(vThrottlingRateLimit)
+	}
+
+	return settings
+}
+
+func flattenApiGatewayV2DefaultRouteSettings(settings *apigatewayv2.RouteSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"data_trace_enabled":       aws.BoolValue(settings.DataTraceEnabled),
+		"detailed_metrics_enabled": aws.BoolValue(settings.DetailedMetricsEnabled),
+		"logging_level":            aws.StringValue(settings.LoggingLevel),
+		"throttling_burst_limit":   int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+		"throttling_rate_limit":    aws.Float64Value(settings.ThrottlingRateLimit),
+	}}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsAthenaWorkgroup() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -114,7 +117,8 @@ func resourceAwsAthenaWorkgroup() *schema.Resource {
 					athena.WorkGroupStateEnabled,
 				}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -135,8 +139,8 @@ func resourceAwsAthenaWorkgroupCreate(d *schema.ResourceData, meta interface{})
 
 	// Prevent the below error:
 	// InvalidRequestException: Tags provided upon WorkGroup creation must not be empty
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		input.Tags = tagsFromMapAthena(v)
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().AthenaTags()
 	}
 
 	_, err := conn.CreateWorkGroup(input)
@@ -163,6 +167,7 @@ func resourceAwsAthenaWorkgroupCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsAthenaWorkgroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).athenaconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &athena.GetWorkGroupInput{
 		WorkGroup: aws.String(d.Id()),
@@ -198,7 +203,7 @@ func resourceAwsAthenaWorkgroupRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", resp.WorkGroup.Name)
 	d.Set("state", resp.WorkGroup.State)
 
-	err = saveTagsAthena(conn, d, d.Get("arn").(string))
+	tags, err := keyvaluetags.AthenaListTags(conn, d.Get("arn").(string))
 
 	if isAWSErr(err, athena.ErrCodeInvalidRequestException, "is not found") {
 		log.Printf("[WARN] Athena WorkGroup (%s) not found, removing from state", d.Id())
@@ -210,6 +215,10 @@ func resourceAwsAthenaWorkgroupRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -261,11 +270,11 @@ func resourceAwsAthenaWorkgroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
-		err := setTagsAthena(conn, d, d.Get("arn").(string))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+		if err := keyvaluetags.AthenaUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Athena WorkGroup (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsBackupPlan() *schema.Resource {
@@ -18,6 +19,8 @@ func resourceAwsBackupPlan() *schema.Resource {
 		Update: resourceAwsBackupPlanUpdate,
 		Delete: resourceAwsBackupPlanDelete,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -85,7 +88,8 @@ func resourceAwsBackupPlan() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -105,8 +109,8 @@ func resourceAwsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error
 		BackupPlan: plan,
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.BackupPlanTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.BackupPlanTags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().BackupTags()
 	}
 
 	resp, err := conn.CreateBackupPlan(input)
@@ -169,7 +173,7 @@ func resourceAwsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags AWS Backup plan %s: %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(tagsOutput.Tags)); err != nil {
+	if err := setTagsAll(d, meta, aws.StringValueMap(tagsOutput.Tags)); err != nil {
 		return fmt.Errorf("error setting tags on AWS Backup plan %s: %s", d.Id(), err)
 	}
 
@@ -200,45 +204,11 @@ func resourceAwsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating Backup Plan: %s", err)
 	}
 
-	if d.HasChange("tags") {
-		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			_, err := conn.UntagResource(&backup.UntagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				TagKeyList:  keys,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error removing tags for (%s): %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&backup.TagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				Tags:        create,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Plan %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error setting tags for (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.BackupUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Backup Plan (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -288,7 +258,7 @@ func expandBackupPlanRules(l []interface{}) []*backup.RuleInput {
 		}
 
 		if item["recovery_point_tags"] != nil {
-			rule.RecoveryPointTags = tagsFromMapGeneric(item["recovery_point_tags"].(map[string]interface{}))
+			rule.RecoveryPointTags = keyvaluetags.New(item["recovery_point_tags"].(map[string]interface{})).IgnoreAws().BackupTags()
 		}
 
 		var lifecycle map[string]interface{}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsBackupVault() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsBackupVault() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -34,6 +37,7 @@ func resourceAwsBackupVault() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tags_all": tagsSchemaTrulyComputed(),
 			"kms_key_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -60,8 +64,8 @@ func resourceAwsBackupVaultCreate(d *schema.ResourceData, meta interface{}) erro
 		BackupVaultName: aws.String(d.Get("name").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.BackupVaultTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.BackupVaultTags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().BackupTags()
 	}
 
 	if v, ok := d.GetOk("kms_key_arn"); ok {
@@ -107,7 +111,7 @@ func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error retrieving Backup Vault (%s) tags: %s", aws.StringValue(resp.BackupVaultArn), err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(tresp.Tags)); err != nil {
+	if err := setTagsAll(d, meta, aws.StringValueMap(tresp.Tags)); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -117,45 +121,11 @@ func resourceAwsBackupVaultRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsBackupVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).backupconn
 
-	if d.HasChange("tags") {
-		resourceArn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.UntagResource(&backup.UntagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				TagKeyList:  keys,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error removing tags for (%s): %s", d.Id(), err)
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			_, err := conn.TagResource(&backup.TagResourceInput{
-				ResourceArn: aws.String(resourceArn),
-				Tags:        create,
-			})
-			if isAWSErr(err, backup.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
-				d.SetId("")
-				return nil
-			}
-			if err != nil {
-				return fmt.Errorf("Error setting tags for (%s): %s", d.Id(), err)
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.BackupUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Backup Vault (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -237,7 +237,7 @@ func resourceAwsBatchComputeEnvironmentCreate(d *schema.ResourceData, meta inter
 			input.ComputeResources.SpotIamFleetRole = aws.String(v.(string))
 		}
 		if v, ok := computeResource["tags"]; ok {
-			input.ComputeResources.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+			input.ComputeResources.Tags = stringMapToPointers(v.(map[string]interface{}))
 		}
 
 		if raw, ok := computeResource["launch_template"]; ok && len(raw.([]interface{})) > 0 {
@@ -333,7 +333,7 @@ func flattenBatchComputeResources(computeResource *batch.ComputeResource) []map[
 	m["security_group_ids"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.SecurityGroupIds))
 	m["spot_iam_fleet_role"] = aws.StringValue(computeResource.SpotIamFleetRole)
 	m["subnets"] = schema.NewSet(schema.HashString, flattenStringList(computeResource.Subnets))
-	m["tags"] = tagsToMapGeneric(computeResource.Tags)
+	m["tags"] = aws.StringValueMap(computeResource.Tags)
 	m["type"] = aws.StringValue(computeResource.Type)
 
 	if launchTemplate := computeResource.LaunchTemplate; launchTemplate != nil {
@@ -13,6 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+const (
+	cloudFormationStackDriftDetectionTimeout = 10 * time.Minute
 )
 
 func resourceAwsCloudFormationStack() *schema.Resource {
@@ -32,6 +37,8 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -106,10 +113,25 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaTrulyComputed(),
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drift_last_check_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_drift": cloudFormationStackResourceDriftSchema(),
 		},
 	}
 }
@@ -155,7 +177,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
@@ -258,6 +280,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 
 func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &cloudformation.DescribeStacksInput{
 		StackName: aws.String(d.Id()),
@@ -332,7 +355,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	err = setTagsAll(d, meta, keyvaluetags.CloudformationKeyValueTags(stack.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 	if err != nil {
 		return err
 	}
@@ -349,6 +372,37 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if stack.DriftInformation != nil {
+		d.Set("drift_status", stack.DriftInformation.StackDriftStatus)
+		d.Set("drift_last_check_timestamp", "")
+		if v := stack.DriftInformation.LastCheckTimestamp; v != nil {
+			d.Set("drift_last_check_timestamp", aws.TimeValue(v).Format(time.RFC3339))
+		}
+	}
+
+	var resourceDrifts []*cloudformation.StackResourceDrift
+
+	// Drift detection can take a while and requires read access to every stack resource,
+	// so it only runs during refresh when explicitly enabled.
+	if d.Get("detect_drift").(bool) {
+		status, err := detectCloudFormationStackDrift(conn, d.Id(), nil)
+		if err != nil {
+			return err
+		}
+
+		d.Set("drift_status", status.StackDriftStatus)
+		d.Set("drift_last_check_timestamp", aws.TimeValue(status.Timestamp).Format(time.RFC3339))
+
+		resourceDrifts, err = listCloudFormationStackResourceDrifts(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation Stack (%s) resource drifts: %s", d.Id(), err)
+		}
+	}
+
+	if err := d.Set("resource_drift", flattenCloudFormationStackResourceDrifts(resourceDrifts)); err != nil {
+		return fmt.Errorf("error setting resource_drift: %s", err)
+	}
+
 	return nil
 }
 
@@ -385,7 +439,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 
@@ -648,3 +702,180 @@ func cfStackEventIsStackDeletion(event *cloudformation.StackEvent) bool {
 		*event.ResourceType == "AWS::CloudFormation::Stack" &&
 		event.ResourceStatusReason != nil
 }
+
+func cloudFormationStackResourceDriftSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"logical_resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"physical_resource_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"property_differences": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"actual_value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"difference_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"expected_value": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"property_path": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"resource_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"stack_resource_drift_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// detectCloudFormationStackDrift starts a drift detection operation on the stack
+// and waits for it to finish, returning the final detection status.
+func detectCloudFormationStackDrift(conn *cloudformation.CloudFormation, stackName string, logicalResourceIds []*string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DetectStackDriftInput{
+		LogicalResourceIds: logicalResourceIds,
+		StackName:          aws.String(stackName),
+	}
+
+	log.Printf("[DEBUG] Detecting CloudFormation Stack drift: %s", input)
+	output, err := conn.DetectStackDrift(input)
+	if err != nil {
+		return nil, fmt.Errorf("error detecting CloudFormation Stack (%s) drift: %s", stackName, err)
+	}
+
+	detectionId := aws.StringValue(output.StackDriftDetectionId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudformation.StackDriftDetectionStatusDetectionInProgress},
+		Target: []string{
+			cloudformation.StackDriftDetectionStatusDetectionComplete,
+			cloudformation.StackDriftDetectionStatusDetectionFailed,
+		},
+		Refresh:    cloudFormationStackDriftDetectionRefreshFunc(conn, detectionId),
+		Timeout:    cloudFormationStackDriftDetectionTimeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for CloudFormation Stack (%s) drift detection (%s): %s", stackName, detectionId, err)
+	}
+
+	status := outputRaw.(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+
+	// A failed detection still reports results for the resources that could be checked.
+	if aws.StringValue(status.DetectionStatus) == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		log.Printf("[WARN] CloudFormation Stack (%s) drift detection (%s) failed for some resources: %s", stackName, detectionId, aws.StringValue(status.DetectionStatusReason))
+	}
+
+	return status, nil
+}
+
+func cloudFormationStackDriftDetectionRefreshFunc(conn *cloudformation.CloudFormation, detectionId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionId),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.DetectionStatus), nil
+	}
+}
+
+func listCloudFormationStackResourceDrifts(conn *cloudformation.CloudFormation, stackName string) ([]*cloudformation.StackResourceDrift, error) {
+	var drifts []*cloudformation.StackResourceDrift
+
+	err := conn.DescribeStackResourceDriftsPages(&cloudformation.DescribeStackResourceDriftsInput{
+		StackName: aws.String(stackName),
+	}, func(page *cloudformation.DescribeStackResourceDriftsOutput, lastPage bool) bool {
+		drifts = append(drifts, page.StackResourceDrifts...)
+		return !lastPage
+	})
+
+	return drifts, err
+}
+
+func flattenCloudFormationStackResourceDrifts(drifts []*cloudformation.StackResourceDrift) []interface{} {
+	l := make([]interface{}, 0, len(drifts))
+
+	for _, drift := range drifts {
+		if drift == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"logical_resource_id":         aws.StringValue(drift.LogicalResourceId),
+			"physical_resource_id":        aws.StringValue(drift.PhysicalResourceId),
+			"property_differences":        flattenCloudFormationStackPropertyDifferences(drift.PropertyDifferences),
+			"resource_type":               aws.StringValue(drift.ResourceType),
+			"stack_resource_drift_status": aws.StringValue(drift.StackResourceDriftStatus),
+			"timestamp":                   "",
+		}
+
+		if drift.Timestamp != nil {
+			m["timestamp"] = aws.TimeValue(drift.Timestamp).Format(time.RFC3339)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenCloudFormationStackPropertyDifferences(differences []*cloudformation.PropertyDifference) []interface{} {
+	l := make([]interface{}, 0, len(differences))
+
+	for _, difference := range differences {
+		if difference == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"actual_value":    aws.StringValue(difference.ActualValue),
+			"difference_type": aws.StringValue(difference.DifferenceType),
+			"expected_value":  aws.StringValue(difference.ExpectedValue),
+			"property_path":   aws.StringValue(difference.PropertyPath),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -258,6 +259,68 @@ func TestAccAWSCloudFormationStack_withUrl_withParams_noUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFormationStack_detectDrift(t *testing.T) {
+	var stack cloudformation.Stack
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackConfig_detectDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", "true"),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusInSync),
+					resource.TestCheckResourceAttrSet(resourceName, "drift_last_check_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusInSync),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccCheckCloudFormationStackVpcDisableDnsSupport(t, "aws_cloudformation_stack.test", &stack)
+				},
+				Config: testAccAWSCloudFormationStackConfig_detectDrift(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", cloudformation.StackDriftStatusDrifted),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.stack_resource_drift_status", cloudformation.StackResourceDriftStatusModified),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.property_differences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_drift.0.property_differences.0.property_path", "/EnableDnsSupport"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFormationStackVpcDisableDnsSupport(t *testing.T, n string, stack *cloudformation.Stack) {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	output, err := conn.DescribeStackResource(&cloudformation.DescribeStackResourceInput{
+		LogicalResourceId: aws.String("MyVPC"),
+		StackName:         stack.StackId,
+	})
+
+	if err != nil {
+		t.Fatalf("error describing CloudFormation Stack (%s) resource: %s", n, err)
+	}
+
+	_, err = ec2conn.ModifyVpcAttribute(&ec2.ModifyVpcAttributeInput{
+		EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(false)},
+		VpcId:            output.StackResourceDetail.PhysicalResourceId,
+	})
+
+	if err != nil {
+		t.Fatalf("error modifying VPC attribute: %s", err)
+	}
+}
+
 func testAccCheckCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -368,6 +431,32 @@ STACK
 `, stackName)
 }
 
+func testAccAWSCloudFormationStackConfig_detectDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name         = %[1]q
+  detect_drift = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "EnableDnsSupport" : true,
+        "Tags" : [
+          {"Key": "Name", "Value": %[1]q}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName)
+}
+
 func testAccAWSCloudFormationStackConfig_yaml(stackName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "yaml" {
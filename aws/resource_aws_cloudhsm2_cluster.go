@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudHsm2Cluster() *schema.Resource {
@@ -29,6 +30,8 @@ func resourceAwsCloudHsm2Cluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
@@ -101,7 +104,8 @@ func resourceAwsCloudHsm2Cluster() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -212,8 +216,12 @@ func resourceAwsCloudHsm2ClusterCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if err := setTagsAwsCloudHsm2Cluster(cloudhsm2, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Cloudhsmv2UpdateTags(cloudhsm2, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating CloudHSMv2 Cluster (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsCloudHsm2ClusterRead(d, meta)
@@ -249,14 +257,28 @@ func resourceAwsCloudHsm2ClusterRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error saving Subnet IDs to state for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
 	}
 
+	tags, err := keyvaluetags.Cloudhsmv2ListTags(meta.(*AWSClient).cloudhsmv2conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudHSMv2 Cluster (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsCloudHsm2ClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	cloudhsm2 := meta.(*AWSClient).cloudhsmv2conn
 
-	if err := setTagsAwsCloudHsm2Cluster(cloudhsm2, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Cloudhsmv2UpdateTags(cloudhsm2, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating CloudHSMv2 Cluster (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsCloudHsm2ClusterRead(d, meta)
@@ -308,48 +330,6 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
-
-			_, err := conn.UntagResource(&cloudhsmv2.UntagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeyList: keys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			tagList := make([]*cloudhsmv2.Tag, 0, len(create))
-			for k, v := range create {
-				tagList = append(tagList, &cloudhsmv2.Tag{
-					Key:   &k,
-					Value: v,
-				})
-			}
-			_, err := conn.TagResource(&cloudhsmv2.TagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagList:    tagList,
-			})
-			if err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
-
 func readCloudHsm2ClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface{} {
 	certs := map[string]interface{}{}
 	if cluster.Certificates != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudWatchLogGroup() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -54,7 +57,8 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -98,6 +102,7 @@ func resourceAwsCloudWatchLogGroupCreate(d *schema.ResourceData, meta interface{
 
 func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	log.Printf("[DEBUG] Reading CloudWatch Log Group: %q", d.Get("name").(string))
 	lg, err := lookupCloudWatchLogGroup(conn, d.Id())
 	if err != nil {
@@ -117,17 +122,15 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("kms_key_id", lg.KmsKeyId)
 	d.Set("retention_in_days", lg.RetentionInDays)
 
-	tags := make(map[string]string)
-	tagsOutput, err := conn.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
-		LogGroupName: aws.String(d.Id()),
-	})
+	tags, err := keyvaluetags.CloudwatchlogsListTags(conn, d.Id())
+
 	if err != nil {
-		return fmt.Errorf("error listing CloudWatch Logs Group %q tags: %s", d.Id(), err)
+		return fmt.Errorf("error listing tags for CloudWatch Logs Group (%s): %s", d.Id(), err)
 	}
-	if tagsOutput != nil {
-		tags = aws.StringValueMap(tagsOutput.Tags)
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
-	d.Set("tags", tags)
 
 	return nil
 }
@@ -181,32 +184,11 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffCloudWatchTags(o, n)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags from %s", name)
-			_, err := conn.UntagLogGroup(&cloudwatchlogs.UntagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         remove,
-			})
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags on %s", name)
-			_, err := conn.TagLogGroup(&cloudwatchlogs.TagLogGroupInput{
-				LogGroupName: aws.String(name),
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
+		if err := keyvaluetags.CloudwatchlogsUpdateTags(conn, name, o, n); err != nil {
+			return fmt.Errorf("error updating CloudWatch Log Group (%s) tags: %s", d.Id(), err)
 		}
 	}
 
@@ -234,23 +216,6 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 	return resourceAwsCloudWatchLogGroupRead(d, meta)
 }
 
-func diffCloudWatchTags(oldTags map[string]interface{}, newTags map[string]interface{}) (map[string]*string, []*string) {
-	create := make(map[string]*string)
-	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
-	}
-
-	var remove []*string
-	for t := range oldTags {
-		_, ok := create[t]
-		if !ok {
-			remove = append(remove, aws.String(t))
-		}
-	}
-
-	return create, remove
-}
-
 func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	log.Printf("[INFO] Deleting CloudWatch Log Group: %s", d.Id())
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
@@ -24,6 +25,8 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"alarm_name": {
 				Type:     schema.TypeString,
@@ -123,8 +126,14 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				ConflictsWith: []string{"extended_statistic", "metric_query"},
 			},
 			"threshold": {
-				Type:     schema.TypeFloat,
-				Required: true,
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"threshold_metric_id"},
+			},
+			"threshold_metric_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"threshold"},
 			},
 			"actions_enabled": {
 				Type:     schema.TypeBool,
@@ -191,7 +200,8 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"evaluate", "ignore"}, true),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -205,6 +215,17 @@ func validateResourceAwsCloudWatchMetricAlarm(d *schema.ResourceData) error {
 		return fmt.Errorf("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
 	}
 
+	_, thresholdOk := d.GetOkExists("threshold")
+	_, thresholdMetricIdOk := d.GetOk("threshold_metric_id")
+
+	if thresholdOk == thresholdMetricIdOk {
+		return fmt.Errorf("One of `threshold` or `threshold_metric_id` must be set for a cloudwatch metric alarm")
+	}
+
+	if thresholdMetricIdOk && d.Get("metric_query").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("`threshold_metric_id` can only be used together with `metric_query` for a cloudwatch metric alarm")
+	}
+
 	if v := d.Get("metric_query"); v != nil {
 		for _, v := range v.(*schema.Set).List() {
 			metricQueryResource := v.(map[string]interface{})
@@ -242,6 +263,8 @@ func resourceAwsCloudWatchMetricAlarmCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+
 	a, err := getAwsCloudWatchMetricAlarm(d, meta)
 	if err != nil {
 		return err
@@ -306,13 +329,22 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 	}
 	d.Set("period", a.Period)
 	d.Set("statistic", a.Statistic)
-	d.Set("threshold", a.Threshold)
+	if a.ThresholdMetricId == nil {
+		d.Set("threshold", a.Threshold)
+	}
+	d.Set("threshold_metric_id", a.ThresholdMetricId)
 	d.Set("unit", a.Unit)
 	d.Set("extended_statistic", a.ExtendedStatistic)
 	d.Set("treat_missing_data", a.TreatMissingData)
 	d.Set("evaluate_low_sample_count_percentiles", a.EvaluateLowSampleCountPercentile)
 
-	if err := saveTagsCloudWatch(meta.(*AWSClient).cloudwatchconn, d, aws.StringValue(a.AlarmArn)); err != nil {
+	tags, err := keyvaluetags.CloudwatchListTags(meta.(*AWSClient).cloudwatchconn, aws.StringValue(a.AlarmArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudWatch Metric Alarm (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -331,8 +363,12 @@ func resourceAwsCloudWatchMetricAlarmUpdate(d *schema.ResourceData, meta interfa
 	log.Println("[INFO] CloudWatch Metric Alarm updated")
 
 	// Tags are cannot update by PutMetricAlarm.
-	if err := setTagsCloudWatch(conn, d, d.Get("arn").(string)); err != nil {
-		return fmt.Errorf("error updating tags for %s: %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CloudwatchUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags for %s: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsCloudWatchMetricAlarmRead(d, meta)
@@ -368,9 +404,8 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		AlarmName:          aws.String(d.Get("alarm_name").(string)),
 		ComparisonOperator: aws.String(d.Get("comparison_operator").(string)),
 		EvaluationPeriods:  aws.Int64(int64(d.Get("evaluation_periods").(int))),
-		Threshold:          aws.Float64(d.Get("threshold").(float64)),
 		TreatMissingData:   aws.String(d.Get("treat_missing_data").(string)),
-		Tags:               tagsFromMapCloudWatch(d.Get("tags").(map[string]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().CloudwatchTags(),
 	}
 
 	if v := d.Get("actions_enabled"); v != nil {
@@ -408,6 +443,12 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		params.ExtendedStatistic = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("threshold_metric_id"); ok {
+		params.ThresholdMetricId = aws.String(v.(string))
+	} else {
+		params.Threshold = aws.Float64(d.Get("threshold").(float64))
+	}
+
 	if v, ok := d.GetOk("evaluate_low_sample_count_percentiles"); ok {
 		params.EvaluateLowSampleCountPercentile = aws.String(v.(string))
 	}
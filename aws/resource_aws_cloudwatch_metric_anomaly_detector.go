@@ -0,0 +1,361 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchMetricAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchMetricAnomalyDetectorCreate,
+		Read:   resourceAwsCloudWatchMetricAnomalyDetectorRead,
+		Update: resourceAwsCloudWatchMetricAnomalyDetectorUpdate,
+		Delete: resourceAwsCloudWatchMetricAnomalyDetectorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.ValidateRFC3339TimeString,
+										DiffSuppressFunc: suppressEquivalentRFC3339TimeDiffs,
+									},
+									"start_time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.ValidateRFC3339TimeString,
+										DiffSuppressFunc: suppressEquivalentRFC3339TimeDiffs,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"stat": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: expandCloudWatchAnomalyDetectorConfiguration(d.Get("configuration").([]interface{})),
+		Dimensions:    expandCloudWatchAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})),
+		MetricName:    aws.String(d.Get("metric_name").(string)),
+		Namespace:     aws.String(d.Get("namespace").(string)),
+		Stat:          aws.String(d.Get("stat").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Metric Anomaly Detector: %s", input)
+	if _, err := conn.PutAnomalyDetector(input); err != nil {
+		return fmt.Errorf("error creating CloudWatch Metric Anomaly Detector: %s", err)
+	}
+
+	d.SetId(cloudWatchMetricAnomalyDetectorCreateID(d.Get("namespace").(string), d.Get("metric_name").(string), d.Get("stat").(string), d.Get("dimensions").(map[string]interface{})))
+
+	return resourceAwsCloudWatchMetricAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	namespace, metricName, stat, dimensions, err := cloudWatchMetricAnomalyDetectorParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	detector, err := findCloudWatchMetricAnomalyDetector(conn, namespace, metricName, stat, dimensions)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if detector == nil {
+		log.Printf("[WARN] CloudWatch Metric Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("configuration", flattenCloudWatchAnomalyDetectorConfiguration(detector.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %s", err)
+	}
+
+	if err := d.Set("dimensions", flattenDimensions(detector.Dimensions)); err != nil {
+		return fmt.Errorf("error setting dimensions: %s", err)
+	}
+
+	d.Set("metric_name", detector.MetricName)
+	d.Set("namespace", detector.Namespace)
+	d.Set("stat", detector.Stat)
+
+	return nil
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	// PutAnomalyDetector replaces the configuration of an existing model.
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: expandCloudWatchAnomalyDetectorConfiguration(d.Get("configuration").([]interface{})),
+		Dimensions:    expandCloudWatchAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})),
+		MetricName:    aws.String(d.Get("metric_name").(string)),
+		Namespace:     aws.String(d.Get("namespace").(string)),
+		Stat:          aws.String(d.Get("stat").(string)),
+	}
+
+	if input.Configuration == nil {
+		input.Configuration = &cloudwatch.AnomalyDetectorConfiguration{}
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Metric Anomaly Detector: %s", input)
+	if _, err := conn.PutAnomalyDetector(input); err != nil {
+		return fmt.Errorf("error updating CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchMetricAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Metric Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteAnomalyDetector(&cloudwatch.DeleteAnomalyDetectorInput{
+		Dimensions: expandCloudWatchAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})),
+		MetricName: aws.String(d.Get("metric_name").(string)),
+		Namespace:  aws.String(d.Get("namespace").(string)),
+		Stat:       aws.String(d.Get("stat").(string)),
+	})
+
+	if isAWSErr(err, cloudwatch.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findCloudWatchMetricAnomalyDetector(conn *cloudwatch.CloudWatch, namespace, metricName, stat string, dimensions map[string]interface{}) (*cloudwatch.AnomalyDetector, error) {
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		Dimensions: expandCloudWatchAnomalyDetectorDimensions(dimensions),
+		MetricName: aws.String(metricName),
+		Namespace:  aws.String(namespace),
+	}
+
+	for {
+		output, err := conn.DescribeAnomalyDetectors(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detector := range output.AnomalyDetectors {
+			if aws.StringValue(detector.Stat) != stat {
+				continue
+			}
+
+			if !reflect.DeepEqual(flattenDimensions(detector.Dimensions), dimensions) {
+				continue
+			}
+
+			return detector, nil
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+// The ID is made up of the namespace, metric name and statistic followed by
+// any dimensions, sorted by name, in Name=Value form. Any "%", "," and "="
+// characters within the parts are percent-encoded.
+func cloudWatchMetricAnomalyDetectorCreateID(namespace, metricName, stat string, dimensions map[string]interface{}) string {
+	parts := []string{
+		cloudWatchMetricAnomalyDetectorIDEscaper.Replace(namespace),
+		cloudWatchMetricAnomalyDetectorIDEscaper.Replace(metricName),
+		cloudWatchMetricAnomalyDetectorIDEscaper.Replace(stat),
+	}
+
+	names := make([]string, 0, len(dimensions))
+	for name := range dimensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s=%s", cloudWatchMetricAnomalyDetectorIDEscaper.Replace(name), cloudWatchMetricAnomalyDetectorIDEscaper.Replace(dimensions[name].(string))))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+var cloudWatchMetricAnomalyDetectorIDEscaper = strings.NewReplacer("%", "%25", ",", "%2C", "=", "%3D")
+
+func cloudWatchMetricAnomalyDetectorParseID(id string) (string, string, string, map[string]interface{}, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", nil, fmt.Errorf("Unexpected format for ID (%q), expected namespace,metric-name,stat[,dimension-name=dimension-value...]", id)
+	}
+
+	for i, part := range parts {
+		if i >= 3 {
+			break
+		}
+
+		v, err := url.PathUnescape(part)
+		if err != nil {
+			return "", "", "", nil, fmt.Errorf("Unexpected format for ID (%q): %s", id, err)
+		}
+		parts[i] = v
+	}
+
+	dimensions := make(map[string]interface{})
+	for _, part := range parts[3:] {
+		kv := strings.Split(part, "=")
+		if len(kv) != 2 || kv[0] == "" {
+			return "", "", "", nil, fmt.Errorf("Unexpected format for dimension (%q) in ID (%q), expected dimension-name=dimension-value", part, id)
+		}
+
+		name, err := url.PathUnescape(kv[0])
+		if err != nil {
+			return "", "", "", nil, fmt.Errorf("Unexpected format for dimension (%q) in ID (%q): %s", part, id, err)
+		}
+
+		value, err := url.PathUnescape(kv[1])
+		if err != nil {
+			return "", "", "", nil, fmt.Errorf("Unexpected format for dimension (%q) in ID (%q): %s", part, id, err)
+		}
+
+		dimensions[name] = value
+	}
+
+	return parts[0], parts[1], parts[2], dimensions, nil
+}
+
+func expandCloudWatchAnomalyDetectorDimensions(m map[string]interface{}) []*cloudwatch.Dimension {
+	if len(m) == 0 {
+		return nil
+	}
+
+	dimensions := make([]*cloudwatch.Dimension, 0, len(m))
+	for k, v := range m {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return dimensions
+}
+
+func expandCloudWatchAnomalyDetectorConfiguration(l []interface{}) *cloudwatch.AnomalyDetectorConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	configuration := &cloudwatch.AnomalyDetectorConfiguration{}
+
+	if v, ok := m["metric_timezone"].(string); ok && v != "" {
+		configuration.MetricTimezone = aws.String(v)
+	}
+
+	for _, raw := range m["excluded_time_range"].([]interface{}) {
+		r, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Values have already been validated as RFC3339.
+		startTime, _ := time.Parse(time.RFC3339, r["start_time"].(string))
+		endTime, _ := time.Parse(time.RFC3339, r["end_time"].(string))
+
+		configuration.ExcludedTimeRanges = append(configuration.ExcludedTimeRanges, &cloudwatch.Range{
+			EndTime:   aws.Time(endTime),
+			StartTime: aws.Time(startTime),
+		})
+	}
+
+	return configuration
+}
+
+func flattenCloudWatchAnomalyDetectorConfiguration(configuration *cloudwatch.AnomalyDetectorConfiguration) []interface{} {
+	if configuration == nil {
+		return []interface{}{}
+	}
+
+	if len(configuration.ExcludedTimeRanges) == 0 && aws.StringValue(configuration.MetricTimezone) == "" {
+		return []interface{}{}
+	}
+
+	ranges := make([]interface{}, 0, len(configuration.ExcludedTimeRanges))
+	for _, r := range configuration.ExcludedTimeRanges {
+		ranges = append(ranges, map[string]interface{}{
+			"end_time":   aws.TimeValue(r.EndTime).Format(time.RFC3339),
+			"start_time": aws.TimeValue(r.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	m := map[string]interface{}{
+		"excluded_time_range": ranges,
+		"metric_timezone":     aws.StringValue(configuration.MetricTimezone),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestCloudWatchMetricAnomalyDetectorParseID(t *testing.T) {
+	testCases := []struct {
+		Namespace  string
+		MetricName string
+		Stat       string
+		Dimensions map[string]interface{}
+		ID         string
+	}{
+		{
+			Namespace:  "AWS/EC2",
+			MetricName: "CPUUtilization",
+			Stat:       "Average",
+			Dimensions: map[string]interface{}{},
+			ID:         "AWS/EC2,CPUUtilization,Average",
+		},
+		{
+			Namespace:  "AWS/EC2",
+			MetricName: "CPUUtilization",
+			Stat:       "Average",
+			Dimensions: map[string]interface{}{"InstanceId": "i-abc123", "AutoScalingGroupName": "example"},
+			ID:         "AWS/EC2,CPUUtilization,Average,AutoScalingGroupName=example,InstanceId=i-abc123",
+		},
+		{
+			Namespace:  "Custom,Namespace",
+			MetricName: "Requests=Total",
+			Stat:       "p99",
+			Dimensions: map[string]interface{}{"Path": "/a,b=c%20"},
+			ID:         "Custom%2CNamespace,Requests%3DTotal,p99,Path=/a%2Cb%3Dc%2520",
+		},
+	}
+
+	for i, tc := range testCases {
+		id := cloudWatchMetricAnomalyDetectorCreateID(tc.Namespace, tc.MetricName, tc.Stat, tc.Dimensions)
+		if id != tc.ID {
+			t.Fatalf("%d: expected ID %q, got %q", i, tc.ID, id)
+		}
+
+		namespace, metricName, stat, dimensions, err := cloudWatchMetricAnomalyDetectorParseID(id)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+
+		if namespace != tc.Namespace || metricName != tc.MetricName || stat != tc.Stat || !reflect.DeepEqual(dimensions, tc.Dimensions) {
+			t.Errorf("%d: expected %q, %q, %q, %v, got %q, %q, %q, %v", i, tc.Namespace, tc.MetricName, tc.Stat, tc.Dimensions, namespace, metricName, stat, dimensions)
+		}
+	}
+
+	for _, id := range []string{"", "AWS/EC2,CPUUtilization", "AWS/EC2,CPUUtilization,Average,InstanceId", "AWS/EC2,CPUUtilization,Average,a=b=c"} {
+		if _, _, _, _, err := cloudWatchMetricAnomalyDetectorParseID(id); err == nil {
+			t.Errorf("expected error parsing ID %q", id)
+		}
+	}
+}
+
+func TestAccAWSCloudWatchMetricAnomalyDetector_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.QueueName", rName),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "ApproximateNumberOfMessagesVisible"),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "AWS/SQS"),
+					resource.TestCheckResourceAttr(resourceName, "stat", "Average"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfigConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2019-11-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2019-11-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		namespace, metricName, stat, dimensions, err := cloudWatchMetricAnomalyDetectorParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		detector, err := findCloudWatchMetricAnomalyDetector(conn, namespace, metricName, stat, dimensions)
+		if err != nil {
+			return err
+		}
+
+		if detector == nil {
+			return fmt.Errorf("CloudWatch Metric Anomaly Detector (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_metric_anomaly_detector" {
+			continue
+		}
+
+		namespace, metricName, stat, dimensions, err := cloudWatchMetricAnomalyDetectorParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		detector, err := findCloudWatchMetricAnomalyDetector(conn, namespace, metricName, stat, dimensions)
+		if err != nil {
+			return err
+		}
+
+		if detector != nil {
+			return fmt.Errorf("CloudWatch Metric Anomaly Detector (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  metric_name = "ApproximateNumberOfMessagesVisible"
+  namespace   = "AWS/SQS"
+  stat        = "Average"
+
+  dimensions = {
+    QueueName = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchMetricAnomalyDetectorConfigConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  metric_name = "ApproximateNumberOfMessagesVisible"
+  namespace   = "AWS/SQS"
+  stat        = "Average"
+
+  dimensions = {
+    QueueName = %[1]q
+  }
+
+  configuration {
+    metric_timezone = "Europe/London"
+
+    excluded_time_range {
+      end_time   = "2019-11-02T00:00:00Z"
+      start_time = "2019-11-01T00:00:00Z"
+    }
+  }
+}
+`, rName)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCodePipelineWebhook() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsCodePipelineWebhook() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"authentication": {
 				Type:     schema.TypeString,
@@ -98,6 +101,7 @@ func resourceAwsCodePipelineWebhook() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags_all": tagsSchemaTrulyComputedForceNew(),
 		},
 	}
 }
@@ -149,7 +153,7 @@ func resourceAwsCodePipelineWebhookCreate(d *schema.ResourceData, meta interface
 			TargetPipeline:              aws.String(d.Get("target_pipeline").(string)),
 			AuthenticationConfiguration: extractCodePipelineWebhookAuthConfig(authType, authConfig),
 		},
-		Tags: tagsFromMapCodePipeline(d.Get("tags").(map[string]interface{})),
+		Tags: keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().CodepipelineTags(),
 	}
 
 	webhook, err := conn.PutWebhook(request)
@@ -229,6 +233,7 @@ func flattenCodePipelineWebhookAuthenticationConfiguration(authConfig *codepipel
 
 func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codepipelineconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	arn := d.Id()
 	webhook, err := getCodePipelineWebhook(conn, arn)
@@ -271,7 +276,7 @@ func resourceAwsCodePipelineWebhookRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting filter: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapCodePipeline(webhook.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.CodepipelineKeyValueTags(webhook.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
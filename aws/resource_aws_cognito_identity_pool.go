@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCognitoIdentityPool() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"identity_pool_name": {
 				Type:         schema.TypeString,
@@ -98,7 +101,8 @@ func resourceAwsCognitoIdentityPool() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -132,8 +136,8 @@ func resourceAwsCognitoIdentityPoolCreate(d *schema.ResourceData, meta interface
 		params.OpenIdConnectProviderARNs = expandStringList(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		params.IdentityPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		params.IdentityPoolTags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().CognitoidentityTags()
 	}
 
 	entity, err := conn.CreateIdentityPool(params)
@@ -148,6 +152,7 @@ func resourceAwsCognitoIdentityPoolCreate(d *schema.ResourceData, meta interface
 
 func resourceAwsCognitoIdentityPoolRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	log.Printf("[DEBUG] Reading Cognito Identity Pool: %s", d.Id())
 
 	ip, err := conn.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{
@@ -172,7 +177,7 @@ func resourceAwsCognitoIdentityPoolRead(d *schema.ResourceData, meta interface{}
 	d.Set("identity_pool_name", ip.IdentityPoolName)
 	d.Set("allow_unauthenticated_identities", ip.AllowUnauthenticatedIdentities)
 	d.Set("developer_provider_name", ip.DeveloperProviderName)
-	if err := d.Set("tags", tagsToMapGeneric(ip.IdentityPoolTags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.CognitoidentityKeyValueTags(ip.IdentityPoolTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("Error setting tags: %s", err)
 	}
 
@@ -232,8 +237,12 @@ func resourceAwsCognitoIdentityPoolUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error updating Cognito Identity Pool: %s", err)
 	}
 
-	if err := setTagsCognito(conn, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.CognitoidentityUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsCognitoIdentityPoolRead(d, meta)
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsConfigConfigRule() *schema.Resource {
@@ -27,6 +28,8 @@ func resourceAwsConfigConfigRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -136,7 +139,8 @@ func resourceAwsConfigConfigRule() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -163,7 +167,7 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 
 	input := configservice.PutConfigRuleInput{
 		ConfigRule: &ruleInput,
-		Tags:       tagsFromMapConfigService(d.Get("tags").(map[string]interface{})),
+		Tags:       keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ConfigserviceTags(),
 	}
 	log.Printf("[DEBUG] Creating AWSConfig config rule: %s", input)
 	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
@@ -193,13 +197,17 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[DEBUG] AWSConfig config rule %q created", name)
 
 	if !d.IsNewResource() {
-		if err := setTagsConfigService(conn, d, d.Get("arn").(string)); err != nil {
-			if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Config Rule not found: %s, removing from state", d.Id())
-				d.SetId("")
-				return nil
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+
+			if err := keyvaluetags.ConfigserviceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+				if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
+					log.Printf("[WARN] Config Rule not found: %s, removing from state", d.Id())
+					d.SetId("")
+					return nil
+				}
+				return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 			}
-			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
 	}
 
@@ -208,6 +216,7 @@ func resourceAwsConfigConfigRulePut(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).configconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	out, err := conn.DescribeConfigRules(&configservice.DescribeConfigRulesInput{
 		ConfigRuleNames: []*string{aws.String(d.Id())},
@@ -249,7 +258,9 @@ func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("source", flattenConfigRuleSource(rule.Source))
 
-	if err := saveTagsConfigService(conn, d, aws.StringValue(rule.ConfigRuleArn)); err != nil {
+	tags, err := keyvaluetags.ConfigserviceListTags(conn, aws.StringValue(rule.ConfigRuleArn))
+
+	if err != nil {
 		if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Config Rule not found: %s, removing from state", d.Id())
 			d.SetId("")
@@ -258,6 +269,10 @@ func resourceAwsConfigConfigRuleRead(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error setting tags for %s: %s", d.Id(), err)
 	}
 
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsConfigConfigurationAggregator() *schema.Resource {
@@ -33,6 +34,7 @@ func resourceAwsConfigConfigurationAggregator() *schema.Resource {
 			customdiff.ForceNewIfChange("organization_aggregation_source", func(old, new, meta interface{}) bool {
 				return len(old.([]interface{})) == 0 && len(new.([]interface{})) > 0
 			}),
+			setTagsDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -106,7 +108,8 @@ func resourceAwsConfigConfigurationAggregator() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -118,7 +121,7 @@ func resourceAwsConfigConfigurationAggregatorPut(d *schema.ResourceData, meta in
 
 	req := &configservice.PutConfigurationAggregatorInput{
 		ConfigurationAggregatorName: aws.String(name),
-		Tags:                        tagsFromMapConfigService(d.Get("tags").(map[string]interface{})),
+		Tags:                        keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ConfigserviceTags(),
 	}
 
 	account_aggregation_sources := d.Get("account_aggregation_source").([]interface{})
@@ -139,13 +142,17 @@ func resourceAwsConfigConfigurationAggregatorPut(d *schema.ResourceData, meta in
 	d.SetId(strings.ToLower(name))
 
 	if !d.IsNewResource() {
-		if err := setTagsConfigService(conn, d, d.Get("arn").(string)); err != nil {
-			if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
-				log.Printf("[WARN] Configuration Aggregator not found: %s, removing from state", d.Id())
-				d.SetId("")
-				return nil
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+
+			if err := keyvaluetags.ConfigserviceUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+				if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
+					log.Printf("[WARN] Configuration Aggregator not found: %s, removing from state", d.Id())
+					d.SetId("")
+					return nil
+				}
+				return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 			}
-			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
 	}
 
@@ -154,6 +161,7 @@ func resourceAwsConfigConfigurationAggregatorPut(d *schema.ResourceData, meta in
 
 func resourceAwsConfigConfigurationAggregatorRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).configconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	req := &configservice.DescribeConfigurationAggregatorsInput{
 		ConfigurationAggregatorNames: []*string{aws.String(d.Id())},
 	}
@@ -186,7 +194,9 @@ func resourceAwsConfigConfigurationAggregatorRead(d *schema.ResourceData, meta i
 		return fmt.Errorf("error setting organization_aggregation_source: %s", err)
 	}
 
-	if err := saveTagsConfigService(conn, d, aws.StringValue(aggregator.ConfigurationAggregatorArn)); err != nil {
+	tags, err := keyvaluetags.ConfigserviceListTags(conn, aws.StringValue(aggregator.ConfigurationAggregatorArn))
+
+	if err != nil {
 		if isAWSErr(err, configservice.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Configiguration Aggregator not found: %s, removing from state", d.Id())
 			d.SetId("")
@@ -195,6 +205,10 @@ func resourceAwsConfigConfigurationAggregatorRead(d *schema.ResourceData, meta i
 		return fmt.Errorf("Error setting tags for %s: %s", d.Id(), err)
 	}
 
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
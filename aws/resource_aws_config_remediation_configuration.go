@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsConfigRemediationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigRemediationConfigurationPut,
+		Read:   resourceAwsConfigRemediationConfigurationRead,
+		Update: resourceAwsConfigRemediationConfigurationPut,
+		Delete: resourceAwsConfigRemediationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"resource_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								configservice.ResourceValueTypeResourceId,
+							}, false),
+						},
+						"static_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 25,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"target_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					configservice.RemediationTargetTypeSsmDocument,
+				}, false),
+			},
+			"target_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConfigRemediationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+	name := d.Get("config_rule_name").(string)
+
+	remediationConfiguration := &configservice.RemediationConfiguration{
+		ConfigRuleName: aws.String(name),
+		Parameters:     expandConfigRemediationConfigurationParameters(d.Get("parameter").(*schema.Set).List()),
+		TargetId:       aws.String(d.Get("target_id").(string)),
+		TargetType:     aws.String(d.Get("target_type").(string)),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		remediationConfiguration.ResourceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_version"); ok {
+		remediationConfiguration.TargetVersion = aws.String(v.(string))
+	}
+
+	input := &configservice.PutRemediationConfigurationsInput{
+		RemediationConfigurations: []*configservice.RemediationConfiguration{remediationConfiguration},
+	}
+
+	log.Printf("[DEBUG] Putting Config Remediation Configuration: %s", input)
+	output, err := conn.PutRemediationConfigurations(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Config Remediation Configuration (%s): %s", name, err)
+	}
+
+	// Failures for individual configurations are reported in the response rather than as an error.
+	if len(output.FailedBatches) > 0 {
+		return fmt.Errorf("error putting Config Remediation Configuration (%s): %s", name, aws.StringValue(output.FailedBatches[0].FailureMessage))
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigRemediationConfigurationRead(d, meta)
+}
+
+func resourceAwsConfigRemediationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+		ConfigRuleNames: []*string{aws.String(d.Id())},
+	})
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Config Remediation Configuration (%s): %s", d.Id(), err)
+	}
+
+	if len(output.RemediationConfigurations) == 0 || output.RemediationConfigurations[0] == nil {
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	remediationConfiguration := output.RemediationConfigurations[0]
+
+	d.Set("config_rule_name", remediationConfiguration.ConfigRuleName)
+	d.Set("resource_type", remediationConfiguration.ResourceType)
+	d.Set("target_id", remediationConfiguration.TargetId)
+	d.Set("target_type", remediationConfiguration.TargetType)
+	d.Set("target_version", remediationConfiguration.TargetVersion)
+
+	if err := d.Set("parameter", flattenConfigRemediationConfigurationParameters(remediationConfiguration.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameter: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigRemediationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.DeleteRemediationConfigurationInput{
+		ConfigRuleName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Config Remediation Configuration: %s", input)
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteRemediationConfiguration(input)
+
+		if isAWSErr(err, configservice.ErrCodeRemediationInProgressException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteRemediationConfiguration(input)
+	}
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchRemediationConfigurationException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Config Remediation Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigRemediationConfigurationParameters(l []interface{}) map[string]*configservice.RemediationParameterValue {
+	if len(l) == 0 {
+		return nil
+	}
+
+	parameters := make(map[string]*configservice.RemediationParameterValue, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value := &configservice.RemediationParameterValue{}
+
+		if v, ok := m["resource_value"].(string); ok && v != "" {
+			value.ResourceValue = &configservice.ResourceValue{
+				Value: aws.String(v),
+			}
+		}
+
+		if v, ok := m["static_values"].([]interface{}); ok && len(v) > 0 {
+			value.StaticValue = &configservice.StaticValue{
+				Values: expandStringList(v),
+			}
+		}
+
+		parameters[m["name"].(string)] = value
+	}
+
+	return parameters
+}
+
+func flattenConfigRemediationConfigurationParameters(parameters map[string]*configservice.RemediationParameterValue) []interface{} {
+	l := make([]interface{}, 0, len(parameters))
+
+	for name, value := range parameters {
+		if value == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"name": name,
+		}
+
+		if value.ResourceValue != nil {
+			m["resource_value"] = aws.StringValue(value.ResourceValue.Value)
+		}
+
+		if value.StaticValue != nil {
+			m["static_values"] = flattenStringList(value.StaticValue.Values)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
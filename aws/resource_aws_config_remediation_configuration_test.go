@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccConfigRemediationConfiguration_basic(t *testing.T) {
+	var rc configservice.RemediationConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_remediation_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig(rName, "AES256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttrPair(resourceName, "config_rule_name", "aws_config_config_rule.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_id", "AWS-EnableS3BucketEncryption"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "SSM_DOCUMENT"),
+					resource.TestCheckResourceAttr(resourceName, "target_version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigRemediationConfiguration_update(t *testing.T) {
+	var rc configservice.RemediationConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_remediation_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig(rName, "AES256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+				),
+			},
+			{
+				Config: testAccConfigRemediationConfigurationConfig(rName, "aws:kms"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigRemediationConfigurationExists(resourceName string, rc *configservice.RemediationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Config Remediation Configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.RemediationConfigurations) == 0 {
+			return fmt.Errorf("Config Remediation Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*rc = *output.RemediationConfigurations[0]
+
+		return nil
+	}
+}
+
+func testAccCheckConfigRemediationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_remediation_configuration" {
+			continue
+		}
+
+		output, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
+			ConfigRuleNames: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.RemediationConfigurations) > 0 {
+			return fmt.Errorf("Config Remediation Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigRemediationConfigurationConfig(rName, sseAlgorithm string) string {
+	return testAccConfigConfigRuleConfig_base(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "remediation" {
+  name = "%[1]s-remediation"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ssm.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_SERVER_SIDE_ENCRYPTION_ENABLED"
+  }
+
+  depends_on = ["aws_config_configuration_recorder.test"]
+}
+
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = "${aws_config_config_rule.test.name}"
+  resource_type    = "AWS::S3::Bucket"
+  target_id        = "AWS-EnableS3BucketEncryption"
+  target_type      = "SSM_DOCUMENT"
+  target_version   = "1"
+
+  parameter {
+    name          = "AutomationAssumeRole"
+    static_values = ["${aws_iam_role.remediation.arn}"]
+  }
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+
+  parameter {
+    name          = "SSEAlgorithm"
+    static_values = [%[2]q]
+  }
+}
+`, rName, sseAlgorithm)
+}
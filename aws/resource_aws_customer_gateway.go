@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsCustomerGateway() *schema.Resource {
@@ -24,6 +25,8 @@ func resourceAwsCustomerGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"bgp_asn": {
 				Type:     schema.TypeInt,
@@ -43,7 +46,8 @@ func resourceAwsCustomerGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -100,8 +104,12 @@ func resourceAwsCustomerGatewayCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Create tags.
-	if err := setTags(conn, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -201,7 +209,9 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 	customerGateway := resp.CustomerGateways[0]
 	d.Set("ip_address", customerGateway.IpAddress)
 	d.Set("type", customerGateway.Type)
-	d.Set("tags", tagsToMap(customerGateway.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(customerGateway.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	if *customerGateway.BgpAsn != "" {
 		val, err := strconv.ParseInt(*customerGateway.BgpAsn, 0, 0)
@@ -219,11 +229,16 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 	conn := meta.(*AWSClient).ec2conn
 
 	// Update tags if required.
-	if err := setTags(conn, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsCustomerGatewayRead(d, meta)
 }
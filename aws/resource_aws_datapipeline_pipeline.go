@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDataPipelinePipeline() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -34,7 +37,8 @@ func resourceAwsDataPipelinePipeline() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -47,7 +51,7 @@ func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interfac
 	input := datapipeline.CreatePipelineInput{
 		Name:     aws.String(d.Get("name").(string)),
 		UniqueId: aws.String(uniqueID),
-		Tags:     tagsFromMapDataPipeline(d.Get("tags").(map[string]interface{})),
+		Tags:     keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().DatapipelineTags(),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -67,6 +71,7 @@ func resourceAwsDataPipelinePipelineCreate(d *schema.ResourceData, meta interfac
 
 func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).datapipelineconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	v, err := resourceAwsDataPipelinePipelineRetrieve(d.Id(), conn)
 	if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") || v == nil {
@@ -80,7 +85,7 @@ func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{
 
 	d.Set("name", v.Name)
 	d.Set("description", v.Description)
-	if err := d.Set("tags", tagsToMapDataPipeline(v.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.DatapipelineKeyValueTags(v.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 	return nil
@@ -89,14 +94,18 @@ func resourceAwsDataPipelinePipelineRead(d *schema.ResourceData, meta interface{
 func resourceAwsDataPipelinePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).datapipelineconn
 
-	if err := setTagsDataPipeline(conn, d); err != nil {
-		if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") {
-			log.Printf("[WARN] DataPipeline (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.DatapipelineUpdateTags(conn, d.Id(), o, n); err != nil {
+			if isAWSErr(err, datapipeline.ErrCodePipelineNotFoundException, "") || isAWSErr(err, datapipeline.ErrCodePipelineDeletedException, "") {
+				log.Printf("[WARN] DataPipeline (%s) not found, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			}
 
-		return fmt.Errorf("Error updating tags: %s", err)
+			return fmt.Errorf("Error updating tags: %s", err)
+		}
 	}
 
 	return resourceAwsDataPipelinePipelineRead(d, meta)
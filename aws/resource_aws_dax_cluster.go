@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDaxCluster() *schema.Resource {
@@ -29,6 +30,8 @@ func resourceAwsDaxCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -122,7 +125,8 @@ func resourceAwsDaxCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -174,7 +178,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().DaxTags()
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -263,6 +267,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	req := &dax.DescribeClustersInput{
 		ClusterNames: []*string{aws.String(d.Id())},
 	}
@@ -334,7 +339,9 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.Tags) > 0 {
 		dt = resp.Tags
 	}
-	d.Set("tags", tagsToMapDax(dt))
+	if err := setTagsAll(d, meta, keyvaluetags.DaxKeyValueTags(dt).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -342,8 +349,12 @@ func resourceAwsDaxClusterRead(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsDaxClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).daxconn
 
-	if err := setTagsDax(conn, d, d.Get("arn").(string)); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.DaxUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return err
+		}
 	}
 
 	req := &dax.UpdateClusterInput{
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDbSnapshot() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsDbSnapshot() *schema.Resource {
 			Read: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"db_snapshot_identifier": {
 				Type:     schema.TypeString,
@@ -102,14 +105,15 @@ func resourceAwsDbSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
 
 func resourceAwsDbSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().RdsTags()
 	dBInstanceIdentifier := d.Get("db_instance_identifier").(string)
 
 	params := &rds.CreateDBSnapshotInput{
@@ -144,6 +148,7 @@ func resourceAwsDbSnapshotCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	params := &rds.DescribeDBSnapshotsInput{
 		DBSnapshotIdentifier: aws.String(d.Id()),
@@ -178,8 +183,14 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("snapshot_type", snapshot.SnapshotType)
 	d.Set("status", snapshot.Status)
 	d.Set("vpc_id", snapshot.VpcId)
-	if err := saveTagsRDS(conn, d, aws.StringValue(snapshot.DBSnapshotArn)); err != nil {
-		log.Printf("[WARN] Failed to save tags for RDS Snapshot (%s): %s", d.Id(), err)
+	tags, err := keyvaluetags.RdsListTags(conn, d.Get("db_snapshot_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for RDS DB Snapshot (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -205,40 +216,12 @@ func resourceAwsDbSnapshotDelete(d *schema.ResourceData, meta interface{}) error
 
 func resourceAwsDbSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	arn := d.Get("db_snapshot_arn").(string)
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsRDS(tagsFromMapRDS(oldTagsMap), tagsFromMapRDS(newTagsMap))
-
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &rds.RemoveTagsFromResourceInput{
-				ResourceName: aws.String(arn),
-				TagKeys:      removeTagKeys,
-			}
-
-			log.Printf("[DEBUG] Untagging DB Snapshot: %s", input)
-			if _, err := conn.RemoveTagsFromResource(input); err != nil {
-				return fmt.Errorf("error untagging DB Snapshot (%s): %s", d.Id(), err)
-			}
-		}
 
-		if len(createTags) > 0 {
-			input := &rds.AddTagsToResourceInput{
-				ResourceName: aws.String(arn),
-				Tags:         createTags,
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			log.Printf("[DEBUG] Tagging DB Snapshot: %s", input)
-			if _, err := conn.AddTagsToResource(input); err != nil {
-				return fmt.Errorf("error tagging DB Snapshot (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.RdsUpdateTags(conn, d.Get("db_snapshot_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RDS DB Snapshot (%s) tags: %s", d.Id(), err)
 		}
 	}
 
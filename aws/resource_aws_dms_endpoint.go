@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsDmsEndpoint() *schema.Resource {
@@ -25,6 +26,8 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"certificate_arn": {
 				Type:         schema.TypeString,
@@ -119,6 +122,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaTrulyComputed(),
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -230,7 +234,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().DatabasemigrationserviceTags(),
 	}
 
 	switch d.Get("engine_name").(string) {
@@ -323,6 +327,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	response, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
 		Filters: []*dms.Filter{
@@ -352,7 +357,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	return d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	return setTagsAll(d, meta, keyvaluetags.DatabasemigrationserviceKeyValueTags(tagsResp.TagList).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 }
 
 func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -400,10 +405,11 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
-		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
-		if err != nil {
-			return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.DatabasemigrationserviceUpdateTags(conn, d.Get("endpoint_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DMS Endpoint (%s) tags: %s", d.Id(), err)
 		}
 	}
 
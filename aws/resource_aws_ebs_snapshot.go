@@ -9,12 +9,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEbsSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEbsSnapshotCreate,
 		Read:   resourceAwsEbsSnapshotRead,
+		Update: resourceAwsEbsSnapshotUpdate,
 		Delete: resourceAwsEbsSnapshotDelete,
 
 		Timeouts: &schema.ResourceTimeout{
@@ -22,6 +24,8 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -58,11 +62,8 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -106,8 +107,12 @@ func resourceAwsEbsSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
-		log.Printf("[WARN] error setting tags: %s", err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			log.Printf("[WARN] error setting tags: %s", err)
+		}
 	}
 
 	return resourceAwsEbsSnapshotRead(d, meta)
@@ -145,13 +150,27 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(snapshot.Tags).Map()); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
 	return nil
 }
 
+func resourceAwsEbsSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 EBS Snapshot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEbsSnapshotRead(d, meta)
+}
+
 func resourceAwsEbsSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.DeleteSnapshotInput{
@@ -9,14 +9,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEbsSnapshotCopy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEbsSnapshotCopyCreate,
 		Read:   resourceAwsEbsSnapshotCopyRead,
+		Update: resourceAwsEbsSnapshotCopyUpdate,
 		Delete: resourceAwsEbsSnapshotCopyDelete,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -63,11 +67,8 @@ func resourceAwsEbsSnapshotCopy() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ForceNew: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -101,8 +102,12 @@ func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if err := setTags(conn, d); err != nil {
-		log.Printf("[WARN] error setting tags: %s", err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			log.Printf("[WARN] error setting tags: %s", err)
+		}
 	}
 
 	return resourceAwsEbsSnapshotCopyRead(d, meta)
@@ -132,13 +137,27 @@ func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(snapshot.Tags).Map()); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
 	return nil
 }
 
+func resourceAwsEbsSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 EBS Snapshot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEbsSnapshotCopyRead(d, meta)
+}
+
 func resourceAwsEbsSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.DeleteSnapshotInput{
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEc2CapacityReservation() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsEc2CapacityReservation() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -87,7 +90,8 @@ func resourceAwsEc2CapacityReservation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"tenancy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -137,12 +141,12 @@ func resourceAwsEc2CapacityReservationCreate(d *schema.ResourceData, meta interf
 		opts.Tenancy = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		opts.TagSpecifications = []*ec2.TagSpecification{
 			{
 				// There is no constant in the SDK for this resource type
 				ResourceType: aws.String("capacity-reservation"),
-				Tags:         tagsFromMap(v.(map[string]interface{})),
+				Tags:         keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().Ec2Tags(),
 			},
 		}
 	}
@@ -197,7 +201,7 @@ func resourceAwsEc2CapacityReservationRead(d *schema.ResourceData, meta interfac
 	d.Set("instance_platform", reservation.InstancePlatform)
 	d.Set("instance_type", reservation.InstanceType)
 
-	if err := d.Set("tags", tagsToMap(reservation.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(reservation.Tags).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -211,12 +215,14 @@ func resourceAwsEc2CapacityReservationUpdate(d *schema.ResourceData, meta interf
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
-		if err := setTags(conn, d); err != nil {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return err
-		} else {
-			d.SetPartial("tags")
 		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2ClientVpnAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnAuthorizationRuleCreate,
+		Read:   resourceAwsEc2ClientVpnAuthorizationRuleRead,
+		Delete: resourceAwsEc2ClientVpnAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnAuthorizationRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_network_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"access_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"authorize_all_groups"},
+			},
+			"authorize_all_groups": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+
+	req := &ec2.AuthorizeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		req.AccessGroupId = aws.String(accessGroupID)
+	} else if d.Get("authorize_all_groups").(bool) {
+		req.AuthorizeAllGroups = aws.Bool(true)
+	} else {
+		return fmt.Errorf("one of access_group_id or authorize_all_groups must be set")
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN authorization rule: %#v", req)
+	_, err := conn.AuthorizeClientVpnIngress(req)
+	if err != nil {
+		return fmt.Errorf("Error creating Client VPN authorization rule: %s", err)
+	}
+
+	d.SetId(ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeAuthorizing},
+		Target:  []string{ec2.ClientVpnAuthorizationRuleStatusCodeActive},
+		Refresh: clientVpnAuthorizationRuleRefreshFunc(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN authorization rule to become active: %s", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Client VPN authorization rule (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnAuthorizationRuleRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	rule, err := findEc2ClientVpnAuthorizationRule(conn,
+		d.Get("client_vpn_endpoint_id").(string),
+		d.Get("target_network_cidr").(string),
+		d.Get("access_group_id").(string),
+	)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN authorization rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error reading Client VPN authorization rule: %s", err)
+	}
+
+	if rule == nil || (rule.Status != nil && aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeRevoking) {
+		log.Printf("[WARN] EC2 Client VPN authorization rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", rule.ClientVpnEndpointId)
+	d.Set("target_network_cidr", rule.DestinationCidr)
+	d.Set("access_group_id", rule.GroupId)
+	d.Set("authorize_all_groups", rule.AccessAll)
+	d.Set("description", rule.Description)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+
+	req := &ec2.RevokeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		req.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		req.RevokeAllGroups = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Revoking Client VPN authorization rule: %#v", req)
+	_, err := conn.RevokeClientVpnIngress(req)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnAuthorizationRuleNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error revoking Client VPN authorization rule (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeRevoking},
+		Target:  []string{},
+		Refresh: clientVpnAuthorizationRuleRefreshFunc(conn, endpointID, targetNetworkCidr, accessGroupID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN authorization rule to be revoked: %s", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Client VPN authorization rule (%s) to be revoked: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	endpointID, targetNetworkCidr, accessGroupID, err := ec2ClientVpnAuthorizationRuleParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("client_vpn_endpoint_id", endpointID)
+	d.Set("target_network_cidr", targetNetworkCidr)
+	d.Set("access_group_id", accessGroupID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func clientVpnAuthorizationRuleRefreshFunc(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := findEc2ClientVpnAuthorizationRule(conn, endpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if rule == nil || rule.Status == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeFailed {
+			return rule, ec2.ClientVpnAuthorizationRuleStatusCodeFailed, fmt.Errorf("Client VPN authorization rule failed: %s", aws.StringValue(rule.Status.Message))
+		}
+
+		return rule, aws.StringValue(rule.Status.Code), nil
+	}
+}
+
+// findEc2ClientVpnAuthorizationRule returns the authorization rule matching the specified
+// target network CIDR and access group. An empty access group matches the rule authorizing all groups.
+func findEc2ClientVpnAuthorizationRule(conn *ec2.EC2, endpointID, targetNetworkCidr, accessGroupID string) (*ec2.AuthorizationRule, error) {
+	filters := map[string]string{
+		"destination-cidr": targetNetworkCidr,
+	}
+	if accessGroupID != "" {
+		filters["group-id"] = accessGroupID
+	}
+
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters:             buildEC2AttributeFilterList(filters),
+	}
+
+	var result *ec2.AuthorizationRule
+	err := conn.DescribeClientVpnAuthorizationRulesPages(input, func(page *ec2.DescribeClientVpnAuthorizationRulesOutput, lastPage bool) bool {
+		for _, rule := range page.AuthorizationRules {
+			if rule == nil {
+				continue
+			}
+			if aws.StringValue(rule.DestinationCidr) != targetNetworkCidr || aws.StringValue(rule.GroupId) != accessGroupID {
+				continue
+			}
+			result = rule
+			return false
+		}
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ec2ClientVpnAuthorizationRuleCreateID(endpointID, targetNetworkCidr, accessGroupID string) string {
+	parts := []string{endpointID, targetNetworkCidr}
+	if accessGroupID != "" {
+		parts = append(parts, accessGroupID)
+	}
+	return strings.Join(parts, ",")
+}
+
+func ec2ClientVpnAuthorizationRuleParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], "", nil
+	}
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("Unexpected format for ID (%q), expected endpoint-id,target-network-cidr or endpoint-id,target-network-cidr,group-id", id)
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_basic(t *testing.T) {
+	var rule ec2.AuthorizationRule
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	subnetResourceName := "aws_subnet.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttrPair(resourceName, "target_network_cidr", subnetResourceName, "cidr_block"),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "true"),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_groups(t *testing.T) {
+	var rule ec2.AuthorizationRule
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigGroup(rStr, "group-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", "group-1"),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigGroup(rStr, "group-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", "group-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
+			continue
+		}
+
+		rule, err := findEc2ClientVpnAuthorizationRule(conn,
+			rs.Primary.Attributes["client_vpn_endpoint_id"],
+			rs.Primary.Attributes["target_network_cidr"],
+			rs.Primary.Attributes["access_group_id"],
+		)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("[DESTROY ERROR] Client VPN authorization rule (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(name string, rule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		result, err := findEc2ClientVpnAuthorizationRule(conn,
+			rs.Primary.Attributes["client_vpn_endpoint_id"],
+			rs.Primary.Attributes["target_network_cidr"],
+			rs.Primary.Attributes["access_group_id"],
+		)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Client VPN authorization rule (%s): %s", rs.Primary.ID, err)
+		}
+
+		if result == nil {
+			return fmt.Errorf("Client VPN authorization rule (%s) not found", rs.Primary.ID)
+		}
+
+		*rule = *result
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigBasic(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+  authorize_all_groups   = true
+
+  depends_on = ["aws_ec2_client_vpn_network_association.test"]
+}
+`
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigGroup(rName, groupID string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+  access_group_id        = %[1]q
+  description            = "test"
+
+  depends_on = ["aws_ec2_client_vpn_network_association.test"]
+}
+`, groupID)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
@@ -20,6 +21,8 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
@@ -103,6 +106,10 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 					},
 				},
 			},
+			"client_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -111,7 +118,8 @@ func resourceAwsEc2ClientVpnEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -124,7 +132,7 @@ func resourceAwsEc2ClientVpnEndpointCreate(d *schema.ResourceData, meta interfac
 		ServerCertificateArn: aws.String(d.Get("server_certificate_arn").(string)),
 		TransportProtocol:    aws.String(d.Get("transport_protocol").(string)),
 		SplitTunnel:          aws.Bool(d.Get("split_tunnel").(bool)),
-		TagSpecifications:    ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeClientVpnEndpoint),
+		TagSpecifications:    ec2TagSpecificationsFromMap(d.Get("tags_all").(map[string]interface{}), ec2.ResourceTypeClientVpnEndpoint),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -230,11 +238,28 @@ func resourceAwsEc2ClientVpnEndpointRead(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("error setting connection_log_options: %s", err)
 	}
 
-	err = d.Set("tags", tagsToMap(result.ClientVpnEndpoints[0].Tags))
+	err = setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(result.ClientVpnEndpoints[0].Tags).Map())
 	if err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	configResp, err := conn.ExportClientVpnClientConfiguration(&ec2.ExportClientVpnClientConfigurationInput{
+		ClientVpnEndpointId: aws.String(d.Id()),
+	})
+	// The client configuration may not be readable with the configured
+	// credentials, which should not prevent managing the endpoint itself.
+	if isAWSErr(err, "UnauthorizedOperation", "") || isAWSErr(err, "AccessDenied", "") {
+		log.Printf("[WARN] Unable to export Client VPN endpoint (%s) client configuration: %s", d.Id(), err)
+		d.Set("client_configuration", "")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error exporting Client VPN endpoint client configuration: %s", err)
+	}
+
+	d.Set("client_configuration", configResp.ClientConfiguration)
+
 	return nil
 }
 
@@ -314,10 +339,15 @@ func resourceAwsEc2ClientVpnEndpointUpdate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error modifying Client VPN endpoint: %s", err)
 	}
 
-	if err := setTags(conn, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsEc2ClientVpnEndpointRead(d, meta)
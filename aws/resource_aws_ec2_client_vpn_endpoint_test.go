@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -86,6 +87,7 @@ func TestAccAwsEc2ClientVpnEndpoint_basic(t *testing.T) {
 					testAccCheckAwsEc2ClientVpnEndpointExists("aws_ec2_client_vpn_endpoint.test"),
 					resource.TestCheckResourceAttr("aws_ec2_client_vpn_endpoint.test", "authentication_options.#", "1"),
 					resource.TestCheckResourceAttr("aws_ec2_client_vpn_endpoint.test", "authentication_options.0.type", "certificate-authentication"),
+					resource.TestMatchResourceAttr("aws_ec2_client_vpn_endpoint.test", "client_configuration", regexp.MustCompile(`remote cvpn-endpoint-`)),
 				),
 			},
 
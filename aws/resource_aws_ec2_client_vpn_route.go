@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEc2ClientVpnRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnRouteCreate,
+		Read:   resourceAwsEc2ClientVpnRouteRead,
+		Delete: resourceAwsEc2ClientVpnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnRouteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"target_vpc_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
+	destinationCidr := d.Get("destination_cidr_block").(string)
+
+	req := &ec2.CreateClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Client VPN route: %#v", req)
+	_, err := conn.CreateClientVpnRoute(req)
+	if err != nil {
+		return fmt.Errorf("Error creating Client VPN route: %s", err)
+	}
+
+	d.SetId(ec2ClientVpnRouteCreateID(endpointID, targetSubnetID, destinationCidr))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeCreating},
+		Target:  []string{ec2.ClientVpnRouteStatusCodeActive},
+		Refresh: clientVpnRouteRefreshFunc(conn, endpointID, targetSubnetID, destinationCidr),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN route to become active: %s", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Client VPN route (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnRouteRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	route, err := findEc2ClientVpnRoute(conn,
+		d.Get("client_vpn_endpoint_id").(string),
+		d.Get("target_vpc_subnet_id").(string),
+		d.Get("destination_cidr_block").(string),
+	)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error reading Client VPN route: %s", err)
+	}
+
+	if route == nil || (route.Status != nil && aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeDeleting) {
+		log.Printf("[WARN] EC2 Client VPN route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", route.ClientVpnEndpointId)
+	d.Set("destination_cidr_block", route.DestinationCidr)
+	d.Set("target_vpc_subnet_id", route.TargetSubnet)
+	d.Set("description", route.Description)
+	d.Set("origin", route.Origin)
+	d.Set("type", route.Type)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	endpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
+	destinationCidr := d.Get("destination_cidr_block").(string)
+
+	log.Printf("[DEBUG] Deleting Client VPN route: %s", d.Id())
+	_, err := conn.DeleteClientVpnRoute(&ec2.DeleteClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(endpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	})
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnRouteNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error deleting Client VPN route (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: clientVpnRouteRefreshFunc(conn, endpointID, targetSubnetID, destinationCidr),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for Client VPN route to be deleted: %s", d.Id())
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for Client VPN route (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	endpointID, targetSubnetID, destinationCidr, err := ec2ClientVpnRouteParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("client_vpn_endpoint_id", endpointID)
+	d.Set("target_vpc_subnet_id", targetSubnetID)
+	d.Set("destination_cidr_block", destinationCidr)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func clientVpnRouteRefreshFunc(conn *ec2.EC2, endpointID, targetSubnetID, destinationCidr string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, err := findEc2ClientVpnRoute(conn, endpointID, targetSubnetID, destinationCidr)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if route == nil || route.Status == nil {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeFailed {
+			return route, ec2.ClientVpnRouteStatusCodeFailed, fmt.Errorf("Client VPN route failed: %s", aws.StringValue(route.Status.Message))
+		}
+
+		return route, aws.StringValue(route.Status.Code), nil
+	}
+}
+
+func findEc2ClientVpnRoute(conn *ec2.EC2, endpointID, targetSubnetID, destinationCidr string) (*ec2.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(endpointID),
+		Filters: buildEC2AttributeFilterList(map[string]string{
+			"destination-cidr": destinationCidr,
+			"target-subnet":    targetSubnetID,
+		}),
+	}
+
+	var result *ec2.ClientVpnRoute
+	err := conn.DescribeClientVpnRoutesPages(input, func(page *ec2.DescribeClientVpnRoutesOutput, lastPage bool) bool {
+		for _, route := range page.Routes {
+			if route == nil {
+				continue
+			}
+			if aws.StringValue(route.DestinationCidr) != destinationCidr || aws.StringValue(route.TargetSubnet) != targetSubnetID {
+				continue
+			}
+			result = route
+			return false
+		}
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func ec2ClientVpnRouteCreateID(endpointID, targetSubnetID, destinationCidr string) string {
+	return strings.Join([]string{endpointID, targetSubnetID, destinationCidr}, ",")
+}
+
+func ec2ClientVpnRouteParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("Unexpected format for ID (%q), expected endpoint-id,target-subnet-id,destination-cidr-block", id)
+}
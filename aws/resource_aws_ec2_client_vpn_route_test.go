@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnRoute_basic(t *testing.T) {
+	var route ec2.ClientVpnRoute
+	resourceName := "aws_ec2_client_vpn_route.test"
+	subnetResourceName := "aws_subnet.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfigBasic(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "origin", "add-route"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_subnet_id", subnetResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Nat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_route" {
+			continue
+		}
+
+		route, err := findEc2ClientVpnRoute(conn,
+			rs.Primary.Attributes["client_vpn_endpoint_id"],
+			rs.Primary.Attributes["target_vpc_subnet_id"],
+			rs.Primary.Attributes["destination_cidr_block"],
+		)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if route != nil {
+			return fmt.Errorf("[DESTROY ERROR] Client VPN route (%s) not deleted", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnRouteExists(name string, route *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		result, err := findEc2ClientVpnRoute(conn,
+			rs.Primary.Attributes["client_vpn_endpoint_id"],
+			rs.Primary.Attributes["target_vpc_subnet_id"],
+			rs.Primary.Attributes["destination_cidr_block"],
+		)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Client VPN route (%s): %s", rs.Primary.ID, err)
+		}
+
+		if result == nil {
+			return fmt.Errorf("Client VPN route (%s) not found", rs.Primary.ID)
+		}
+
+		*route = *result
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnRouteConfigBasic(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+resource "aws_ec2_client_vpn_route" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  destination_cidr_block = "0.0.0.0/0"
+  target_vpc_subnet_id   = "${aws_ec2_client_vpn_network_association.test.subnet_id}"
+  description            = "test"
+}
+`
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEc2Fleet() *schema.Resource {
@@ -28,6 +29,8 @@ func resourceAwsEc2Fleet() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"excess_capacity_termination_policy": {
 				Type:     schema.TypeString,
@@ -198,6 +201,7 @@ func resourceAwsEc2Fleet() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tags_all": tagsSchemaTrulyComputedForceNew(),
 			"target_capacity_specification": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -312,7 +316,7 @@ func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
 		SpotOptions:                      expandEc2SpotOptionsRequest(d.Get("spot_options").([]interface{})),
 		TargetCapacitySpecification:      expandEc2TargetCapacitySpecificationRequest(d.Get("target_capacity_specification").([]interface{})),
 		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
-		TagSpecifications:                expandEc2TagSpecifications(d.Get("tags").(map[string]interface{})),
+		TagSpecifications:                expandEc2TagSpecifications(d.Get("tags_all").(map[string]interface{})),
 		Type:                             aws.String(d.Get("type").(string)),
 	}
 
@@ -354,6 +358,7 @@ func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &ec2.DescribeFleetsInput{
 		FleetIds: []*string{aws.String(d.Id())},
@@ -435,7 +440,7 @@ func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("terminate_instances_with_expiration", fleet.TerminateInstancesWithExpiration)
 	d.Set("type", fleet.Type)
 
-	if err := d.Set("tags", tagsToMap(fleet.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(fleet.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -701,7 +706,7 @@ func expandEc2TagSpecifications(m map[string]interface{}) []*ec2.TagSpecificatio
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String("fleet"),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }
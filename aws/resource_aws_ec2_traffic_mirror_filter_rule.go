@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TrafficMirrorFilterRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorFilterRuleCreate,
+		Read:   resourceAwsEc2TrafficMirrorFilterRuleRead,
+		Update: resourceAwsEc2TrafficMirrorFilterRuleUpdate,
+		Delete: resourceAwsEc2TrafficMirrorFilterRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2TrafficMirrorFilterRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"destination_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+			"protocol": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"rule_action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TrafficMirrorRuleActionAccept,
+					ec2.TrafficMirrorRuleActionReject,
+				}, false),
+			},
+			"rule_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32766),
+			},
+			"source_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"source_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+			"traffic_direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TrafficDirectionIngress,
+					ec2.TrafficDirectionEgress,
+				}, false),
+			},
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorFilterRuleInput{
+		DestinationCidrBlock:  aws.String(d.Get("destination_cidr_block").(string)),
+		RuleAction:            aws.String(d.Get("rule_action").(string)),
+		RuleNumber:            aws.Int64(int64(d.Get("rule_number").(int))),
+		SourceCidrBlock:       aws.String(d.Get("source_cidr_block").(string)),
+		TrafficDirection:      aws.String(d.Get("traffic_direction").(string)),
+		TrafficMirrorFilterId: aws.String(d.Get("traffic_mirror_filter_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_port_range"); ok {
+		input.DestinationPortRange = expandEc2TrafficMirrorPortRangeRequest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("protocol"); ok {
+		input.Protocol = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("source_port_range"); ok {
+		input.SourcePortRange = expandEc2TrafficMirrorPortRangeRequest(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Filter Rule: %s", input)
+	out, err := conn.CreateTrafficMirrorFilterRule(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Filter Rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficMirrorFilterRule.TrafficMirrorFilterRuleId))
+
+	return resourceAwsEc2TrafficMirrorFilterRuleRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	filterID := d.Get("traffic_mirror_filter_id").(string)
+
+	filter, err := describeEc2TrafficMirrorFilter(conn, filterID)
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing rule (%s) from state", filterID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %s", filterID, err)
+	}
+
+	var rule *ec2.TrafficMirrorFilterRule
+	if filter != nil {
+		rule = findEc2TrafficMirrorFilterRule(filter, d.Id())
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", rule.Description)
+	d.Set("destination_cidr_block", rule.DestinationCidrBlock)
+	d.Set("protocol", rule.Protocol)
+	d.Set("rule_action", rule.RuleAction)
+	d.Set("rule_number", rule.RuleNumber)
+	d.Set("source_cidr_block", rule.SourceCidrBlock)
+	d.Set("traffic_direction", rule.TrafficDirection)
+	d.Set("traffic_mirror_filter_id", rule.TrafficMirrorFilterId)
+
+	if err := d.Set("destination_port_range", flattenEc2TrafficMirrorPortRange(rule.DestinationPortRange)); err != nil {
+		return fmt.Errorf("error setting destination_port_range: %s", err)
+	}
+
+	if err := d.Set("source_port_range", flattenEc2TrafficMirrorPortRange(rule.SourcePortRange)); err != nil {
+		return fmt.Errorf("error setting source_port_range: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
+		TrafficMirrorFilterRuleId: aws.String(d.Id()),
+	}
+
+	var removeFields []string
+
+	if d.HasChange("description") {
+		if v := d.Get("description").(string); v != "" {
+			input.Description = aws.String(v)
+		} else {
+			removeFields = append(removeFields, ec2.TrafficMirrorFilterRuleFieldDescription)
+		}
+	}
+
+	if d.HasChange("destination_cidr_block") {
+		input.DestinationCidrBlock = aws.String(d.Get("destination_cidr_block").(string))
+	}
+
+	if d.HasChange("destination_port_range") {
+		if v := d.Get("destination_port_range").([]interface{}); len(v) > 0 {
+			input.DestinationPortRange = expandEc2TrafficMirrorPortRangeRequest(v)
+		} else {
+			removeFields = append(removeFields, ec2.TrafficMirrorFilterRuleFieldDestinationPortRange)
+		}
+	}
+
+	if d.HasChange("protocol") {
+		if v := d.Get("protocol").(int); v != 0 {
+			input.Protocol = aws.Int64(int64(v))
+		} else {
+			removeFields = append(removeFields, ec2.TrafficMirrorFilterRuleFieldProtocol)
+		}
+	}
+
+	if d.HasChange("rule_action") {
+		input.RuleAction = aws.String(d.Get("rule_action").(string))
+	}
+
+	if d.HasChange("rule_number") {
+		input.RuleNumber = aws.Int64(int64(d.Get("rule_number").(int)))
+	}
+
+	if d.HasChange("source_cidr_block") {
+		input.SourceCidrBlock = aws.String(d.Get("source_cidr_block").(string))
+	}
+
+	if d.HasChange("source_port_range") {
+		if v := d.Get("source_port_range").([]interface{}); len(v) > 0 {
+			input.SourcePortRange = expandEc2TrafficMirrorPortRangeRequest(v)
+		} else {
+			removeFields = append(removeFields, ec2.TrafficMirrorFilterRuleFieldSourcePortRange)
+		}
+	}
+
+	if d.HasChange("traffic_direction") {
+		input.TrafficDirection = aws.String(d.Get("traffic_direction").(string))
+	}
+
+	if len(removeFields) > 0 {
+		input.RemoveFields = aws.StringSlice(removeFields)
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Traffic Mirror Filter Rule: %s", input)
+	if _, err := conn.ModifyTrafficMirrorFilterRule(input); err != nil {
+		return fmt.Errorf("error modifying EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRuleRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter Rule: %s", d.Id())
+	_, err := conn.DeleteTrafficMirrorFilterRule(&ec2.DeleteTrafficMirrorFilterRuleInput{
+		TrafficMirrorFilterRuleId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterRuleId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format for ID (%q), expected traffic-mirror-filter-id:traffic-mirror-filter-rule-id", d.Id())
+	}
+
+	d.Set("traffic_mirror_filter_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findEc2TrafficMirrorFilterRule(filter *ec2.TrafficMirrorFilter, ruleID string) *ec2.TrafficMirrorFilterRule {
+	for _, rules := range [][]*ec2.TrafficMirrorFilterRule{filter.IngressFilterRules, filter.EgressFilterRules} {
+		for _, rule := range rules {
+			if aws.StringValue(rule.TrafficMirrorFilterRuleId) == ruleID {
+				return rule
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandEc2TrafficMirrorPortRangeRequest(l []interface{}) *ec2.TrafficMirrorPortRangeRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	portRange := &ec2.TrafficMirrorPortRangeRequest{}
+
+	if v, ok := m["from_port"].(int); ok {
+		portRange.FromPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["to_port"].(int); ok {
+		portRange.ToPort = aws.Int64(int64(v))
+	}
+
+	return portRange
+}
+
+func flattenEc2TrafficMirrorPortRange(portRange *ec2.TrafficMirrorPortRange) []interface{} {
+	if portRange == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"from_port": aws.Int64Value(portRange.FromPort),
+		"to_port":   aws.Int64Value(portRange.ToPort),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorFilterRule_basic(t *testing.T) {
+	var rule ec2.TrafficMirrorFilterRule
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", "ingress"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigAllAttributes(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "description", "test rule"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.from_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.to_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "reject"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.from_port", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.to_port", "65535"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", "egress"),
+				),
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
+			continue
+		}
+
+		filter, err := describeEc2TrafficMirrorFilter(conn, rs.Primary.Attributes["traffic_mirror_filter_id"])
+
+		if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if filter != nil && findEc2TrafficMirrorFilterRule(filter, rs.Primary.ID) != nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleExists(name string, rule *ec2.TrafficMirrorFilterRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Filter Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		filter, err := describeEc2TrafficMirrorFilter(conn, rs.Primary.Attributes["traffic_mirror_filter_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if filter == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter (%s) not found", rs.Primary.Attributes["traffic_mirror_filter_id"])
+		}
+
+		out := findEc2TrafficMirrorFilterRule(filter, rs.Primary.ID)
+		if out == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*rule = *out
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccEc2TrafficMirrorFilterRuleConfig() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+}
+`
+}
+
+func testAccEc2TrafficMirrorFilterRuleConfigAllAttributes() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  description              = "test rule"
+  destination_cidr_block   = "10.1.0.0/16"
+  protocol                 = 6
+  rule_action              = "reject"
+  rule_number              = 2
+  source_cidr_block        = "10.2.0.0/16"
+  traffic_direction        = "egress"
+
+  destination_port_range {
+    from_port = 80
+    to_port   = 443
+  }
+
+  source_port_range {
+    from_port = 1024
+    to_port   = 65535
+  }
+}
+`
+}
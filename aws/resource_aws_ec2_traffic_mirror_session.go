@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsEc2TrafficMirrorSession() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorSessionCreate,
+		Read:   resourceAwsEc2TrafficMirrorSessionRead,
+		Update: resourceAwsEc2TrafficMirrorSessionUpdate,
+		Delete: resourceAwsEc2TrafficMirrorSessionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"packet_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 8500),
+			},
+			"session_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32766),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"traffic_mirror_target_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"virtual_network_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 16777215),
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorSessionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorSessionInput{
+		NetworkInterfaceId:    aws.String(d.Get("network_interface_id").(string)),
+		SessionNumber:         aws.Int64(int64(d.Get("session_number").(int))),
+		TagSpecifications:     ec2TagSpecificationsFromMap(d.Get("tags_all").(map[string]interface{}), ec2.ResourceTypeTrafficMirrorSession),
+		TrafficMirrorFilterId: aws.String(d.Get("traffic_mirror_filter_id").(string)),
+		TrafficMirrorTargetId: aws.String(d.Get("traffic_mirror_target_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("packet_length"); ok {
+		input.PacketLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("virtual_network_id"); ok {
+		input.VirtualNetworkId = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Session: %s", input)
+	out, err := conn.CreateTrafficMirrorSession(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Session: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.TrafficMirrorSession.TrafficMirrorSessionId))
+
+	return resourceAwsEc2TrafficMirrorSessionRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorSessionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	out, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
+		TrafficMirrorSessionIds: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Session (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Session (%s): %s", d.Id(), err)
+	}
+
+	if out == nil || len(out.TrafficMirrorSessions) == 0 || out.TrafficMirrorSessions[0] == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Session (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	session := out.TrafficMirrorSessions[0]
+
+	d.Set("description", session.Description)
+	d.Set("network_interface_id", session.NetworkInterfaceId)
+	d.Set("packet_length", session.PacketLength)
+	d.Set("session_number", session.SessionNumber)
+	d.Set("traffic_mirror_filter_id", session.TrafficMirrorFilterId)
+	d.Set("traffic_mirror_target_id", session.TrafficMirrorTargetId)
+	d.Set("virtual_network_id", session.VirtualNetworkId)
+
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(session.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorSessionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("description") || d.HasChange("packet_length") || d.HasChange("session_number") ||
+		d.HasChange("traffic_mirror_filter_id") || d.HasChange("traffic_mirror_target_id") || d.HasChange("virtual_network_id") {
+		input := &ec2.ModifyTrafficMirrorSessionInput{
+			TrafficMirrorSessionId: aws.String(d.Id()),
+		}
+
+		var removeFields []string
+
+		if d.HasChange("description") {
+			if v := d.Get("description").(string); v != "" {
+				input.Description = aws.String(v)
+			} else {
+				removeFields = append(removeFields, ec2.TrafficMirrorSessionFieldDescription)
+			}
+		}
+
+		if d.HasChange("packet_length") {
+			if v := d.Get("packet_length").(int); v != 0 {
+				input.PacketLength = aws.Int64(int64(v))
+			} else {
+				removeFields = append(removeFields, ec2.TrafficMirrorSessionFieldPacketLength)
+			}
+		}
+
+		if d.HasChange("session_number") {
+			input.SessionNumber = aws.Int64(int64(d.Get("session_number").(int)))
+		}
+
+		if d.HasChange("traffic_mirror_filter_id") {
+			input.TrafficMirrorFilterId = aws.String(d.Get("traffic_mirror_filter_id").(string))
+		}
+
+		if d.HasChange("traffic_mirror_target_id") {
+			input.TrafficMirrorTargetId = aws.String(d.Get("traffic_mirror_target_id").(string))
+		}
+
+		if d.HasChange("virtual_network_id") {
+			input.VirtualNetworkId = aws.Int64(int64(d.Get("virtual_network_id").(int)))
+		}
+
+		if len(removeFields) > 0 {
+			input.RemoveFields = aws.StringSlice(removeFields)
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Traffic Mirror Session: %s", input)
+		if _, err := conn.ModifyTrafficMirrorSession(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Traffic Mirror Session (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Traffic Mirror Session (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TrafficMirrorSessionRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorSessionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Session: %s", d.Id())
+	_, err := conn.DeleteTrafficMirrorSession(&ec2.DeleteTrafficMirrorSessionInput{
+		TrafficMirrorSessionId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Session (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorSession_basic(t *testing.T) {
+	var session ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorSessionConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.src", "primary_network_interface_id"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_target_id", "aws_ec2_traffic_mirror_target.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_network_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2TrafficMirrorSessionConfigAllAttributes(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", "test session"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "100"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_id", "1234"),
+				),
+			},
+			{
+				Config: testAccEc2TrafficMirrorSessionConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorSession_Tags(t *testing.T) {
+	var session ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorSessionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2TrafficMirrorSessionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &session),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_session" {
+			continue
+		}
+
+		out, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
+			TrafficMirrorSessionIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if isAWSErr(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(out.TrafficMirrorSessions) != 0 {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionExists(name string, session *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Session ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		out, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
+			TrafficMirrorSessionIds: aws.StringSlice([]string{rs.Primary.ID}),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(out.TrafficMirrorSessions) == 0 {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) not found", rs.Primary.ID)
+		}
+
+		*session = *out.TrafficMirrorSessions[0]
+
+		return nil
+	}
+}
+
+func testAccEc2TrafficMirrorSessionConfigBase(rName string) string {
+	return testAccEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+data "aws_ami" "amzn-linux" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-ebs"]
+  }
+}
+
+# Traffic mirror sources must be Nitro-based instances.
+resource "aws_instance" "src" {
+  ami           = "${data.aws_ami.amzn-linux.id}"
+  instance_type = "m5.large"
+  subnet_id     = "${aws_subnet.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = "${aws_subnet.test.id}"
+}
+
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+}
+`, rName)
+}
+
+func testAccEc2TrafficMirrorSessionConfig(rName string, sessionNumber int) string {
+	return testAccEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.src.primary_network_interface_id}"
+  session_number           = %[1]d
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+}
+`, sessionNumber)
+}
+
+func testAccEc2TrafficMirrorSessionConfigAllAttributes(rName string, sessionNumber int) string {
+	return testAccEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  description              = "test session"
+  network_interface_id     = "${aws_instance.src.primary_network_interface_id}"
+  packet_length            = 100
+  session_number           = %[1]d
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+  virtual_network_id       = 1234
+}
+`, sessionNumber)
+}
+
+func testAccEc2TrafficMirrorSessionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.src.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEcrRepository() *schema.Resource {
@@ -27,6 +28,8 @@ func resourceAwsEcrRepository() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -42,7 +45,8 @@ func resourceAwsEcrRepository() *schema.Resource {
 					ecr.ImageTagMutabilityImmutable,
 				}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -65,7 +69,7 @@ func resourceAwsEcrRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 	input := ecr.CreateRepositoryInput{
 		ImageTagMutability: aws.String(d.Get("image_tag_mutability").(string)),
 		RepositoryName:     aws.String(d.Get("name").(string)),
-		Tags:               tagsFromMapECR(d.Get("tags").(map[string]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().EcrTags(),
 	}
 
 	log.Printf("[DEBUG] Creating ECR repository: %#v", input)
@@ -85,6 +89,7 @@ func resourceAwsEcrRepositoryCreate(d *schema.ResourceData, meta interface{}) er
 
 func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	log.Printf("[DEBUG] Reading ECR repository %s", d.Id())
 	var out *ecr.DescribeRepositoriesOutput
@@ -126,8 +131,14 @@ func resourceAwsEcrRepositoryRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("repository_url", repository.RepositoryUri)
 	d.Set("image_tag_mutability", repository.ImageTagMutability)
 
-	if err := getTagsECR(conn, d); err != nil {
-		return fmt.Errorf("error getting ECR repository tags: %s", err)
+	tags, err := keyvaluetags.EcrListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for ECR Repository (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	return nil
@@ -142,8 +153,12 @@ func resourceAwsEcrRepositoryUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if err := setTagsECR(conn, d); err != nil {
-		return fmt.Errorf("error setting ECR repository tags: %s", err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.EcrUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error setting ECR repository tags: %s", err)
+		}
 	}
 
 	return resourceAwsEcrRepositoryRead(d, meta)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsEfsFileSystem() *schema.Resource {
@@ -26,6 +27,8 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -83,7 +86,8 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"throughput_mode": {
 				Type:     schema.TypeString,
@@ -193,9 +197,12 @@ func resourceAwsEfsFileSystemCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	err = setTagsEFS(conn, d)
-	if err != nil {
-		return fmt.Errorf("error setting tags for EFS file system (%q): %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.EfsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error setting tags for EFS file system (%q): %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsEfsFileSystemRead(d, meta)
@@ -247,9 +254,10 @@ func resourceAwsEfsFileSystemUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
-		err := setTagsEFS(conn, d)
-		if err != nil {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.EfsUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("Error setting EC2 tags for EFS file system (%q): %s",
 				d.Id(), err.Error())
 		}
@@ -302,9 +310,8 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
-	if err != nil {
-		return err
+	if err := setTagsAll(d, meta, keyvaluetags.EfsKeyValueTags(tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
 	}
 
 	var fs *efs.FileSystemDescription
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsElasticacheCluster() *schema.Resource {
@@ -233,7 +234,8 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -292,6 +294,7 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 				}
 				return diff.ForceNew("node_type")
 			},
+			setTagsDiff,
 		),
 	}
 }
@@ -308,7 +311,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 		securityIdSet := d.Get("security_group_ids").(*schema.Set)
 		securityNames := expandStringList(securityNameSet.List())
 		securityIds := expandStringList(securityIdSet.List())
-		tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+		tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ElasticacheTags()
 
 		req.CacheSecurityGroupNames = securityNames
 		req.SecurityGroupIds = securityIds
@@ -404,6 +407,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	req := &elasticache.DescribeCacheClustersInput{
 		CacheClusterId:    aws.String(d.Id()),
 		ShowCacheNodeInfo: aws.Bool(true),
@@ -484,7 +488,9 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 		if len(resp.TagList) > 0 {
 			et = resp.TagList
 		}
-		d.Set("tags", tagsToMapEC(et))
+		if err := setTagsAll(d, meta, keyvaluetags.ElasticacheKeyValueTags(et).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
 	}
 
 	return nil
@@ -500,8 +506,12 @@ func resourceAwsElasticacheClusterUpdate(d *schema.ResourceData, meta interface{
 		AccountID: meta.(*AWSClient).accountid,
 		Resource:  fmt.Sprintf("cluster:%s", d.Id()),
 	}.String()
-	if err := setTagsEC(conn, d, arn); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticacheUpdateTags(conn, arn, o, n); err != nil {
+			return err
+		}
 	}
 
 	req := &elasticache.ModifyCacheClusterInput{
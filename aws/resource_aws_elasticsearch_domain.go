@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsElasticSearchDomain() *schema.Resource {
@@ -50,6 +51,7 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				}
 				return true
 			}),
+			setTagsDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -324,7 +326,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -337,6 +340,7 @@ func resourceAwsElasticSearchDomainImport(
 
 func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	// The API doesn't check for duplicate names
 	// so w/out this check Create would act as upsert
@@ -487,14 +491,21 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ElasticsearchserviceTags()
 
-	if err := setTagsElasticsearchService(conn, d, aws.StringValue(out.DomainStatus.ARN)); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticsearchserviceUpdateTags(conn, aws.StringValue(out.DomainStatus.ARN), o, n); err != nil {
+			return err
+		}
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(tags))
+	if err := setTagsAll(d, meta, keyvaluetags.ElasticsearchserviceKeyValueTags(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -528,6 +539,7 @@ func waitForElasticSearchDomainCreation(conn *elasticsearch.ElasticsearchService
 
 func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -638,7 +650,9 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		est = listOut.TagList
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(est))
+	if err := setTagsAll(d, meta, keyvaluetags.ElasticsearchserviceKeyValueTags(est).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -648,11 +662,16 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 
 	d.Partial(true)
 
-	if err := setTagsElasticsearchService(conn, d, d.Id()); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.ElasticsearchserviceUpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
@@ -1494,3 +1495,25 @@ resource "aws_elasticsearch_domain" "example" {
 }
 `, randInt, randInt, randInt, randInt, randInt, cognitoOptions)
 }
+
+func testAccCheckElasticsearchServiceTags(
+	ts *[]*elasticsearch.Tag, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		m := keyvaluetags.ElasticsearchserviceKeyValueTags(*ts).Map()
+		v, ok := m[key]
+		if value != "" && !ok {
+			return fmt.Errorf("Missing tag: %s", key)
+		} else if value == "" && ok {
+			return fmt.Errorf("Extra tag: %s", key)
+		}
+		if value == "" {
+			return nil
+		}
+
+		if v != value {
+			return fmt.Errorf("%s: bad value: %s", key, v)
+		}
+
+		return nil
+	}
+}
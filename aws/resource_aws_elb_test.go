@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func init() {
@@ -1879,3 +1880,25 @@ resource "aws_internet_gateway" "gw" {
   }
 }
 `
+
+func testAccCheckELBTags(
+	ts *[]*elb.Tag, key string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		m := keyvaluetags.ElbKeyValueTags(*ts).Map()
+		v, ok := m[key]
+		if value != "" && !ok {
+			return fmt.Errorf("Missing tag: %s", key)
+		} else if value == "" && ok {
+			return fmt.Errorf("Extra tag: %s", key)
+		}
+		if value == "" {
+			return nil
+		}
+
+		if v != value {
+			return fmt.Errorf("%s: bad value: %s", key, v)
+		}
+
+		return nil
+	}
+}
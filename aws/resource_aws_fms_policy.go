@@ -0,0 +1,382 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// fmsPolicyResourceTypeList is the ResourceType the FMS API expects when a
+// policy protects the resource types listed in ResourceTypeList.
+// See https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_Policy.html.
+const fmsPolicyResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsFmsPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude_map": fmsPolicyScopeSchema(),
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": fmsPolicyScopeSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_tags": tagsSchema(),
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"resource_type_list"},
+			},
+			"resource_type_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"resource_type"},
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.ValidateJsonString,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+							StateFunc: func(v interface{}) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fms.SecurityServiceTypeShieldAdvanced,
+								fms.SecurityServiceTypeWaf,
+								// Not yet modelled as constants in the vendored SDK.
+								"SECURITY_GROUPS_COMMON",
+								"SECURITY_GROUPS_CONTENT_AUDIT",
+								"SECURITY_GROUPS_USAGE_AUDIT",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fmsPolicyScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateAwsAccountId,
+					},
+					Set: schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsFmsPolicyCustomizeDiff requires one of resource_type or
+// resource_type_list to be configured.
+func resourceAwsFmsPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("resource_type") || !diff.NewValueKnown("resource_type_list") {
+		return nil
+	}
+
+	_, resourceTypeOk := diff.GetOk("resource_type")
+	_, resourceTypeListOk := diff.GetOk("resource_type_list")
+
+	if !resourceTypeOk && !resourceTypeListOk {
+		return fmt.Errorf("one of `resource_type` or `resource_type_list` must be set")
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: expandFmsPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetPolicy(&fms.GetPolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyScope(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %s", err)
+	}
+
+	if err := d.Set("include_map", flattenFmsPolicyScope(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %s", err)
+	}
+
+	if err := d.Set("resource_tags", flattenFmsPolicyResourceTags(policy.ResourceTags)); err != nil {
+		return fmt.Errorf("error setting resource_tags: %s", err)
+	}
+
+	// Policies covering several resource types report fmsPolicyResourceTypeList
+	// as their resource type, which is not a value the user configured.
+	if aws.StringValue(policy.ResourceType) == fmsPolicyResourceTypeList {
+		d.Set("resource_type", "")
+
+		if err := d.Set("resource_type_list", flattenStringSet(policy.ResourceTypeList)); err != nil {
+			return fmt.Errorf("error setting resource_type_list: %s", err)
+		}
+	} else {
+		d.Set("resource_type", policy.ResourceType)
+
+		if err := d.Set("resource_type_list", nil); err != nil {
+			return fmt.Errorf("error setting resource_type_list: %s", err)
+		}
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := expandFmsPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+	if _, err := conn.PutPolicy(input); err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.DeletePolicyInput{
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+		PolicyId:                 aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", input)
+	_, err := conn.DeletePolicy(input)
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFmsPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:                expandFmsPolicyScope(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags:       aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:                expandFmsPolicyScope(d.Get("include_map").([]interface{})),
+		PolicyName:                aws.String(d.Get("name").(string)),
+		RemediationEnabled:        aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:              expandFmsPolicyResourceTags(d.Get("resource_tags").(map[string]interface{})),
+		SecurityServicePolicyData: expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceType = aws.String(fmsPolicyResourceTypeList)
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	} else if v, ok := d.GetOk("resource_type"); ok {
+		policy.ResourceType = aws.String(v.(string))
+	}
+
+	return policy
+}
+
+func expandFmsPolicyScope(l []interface{}) map[string][]*string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	scope := make(map[string][]*string)
+
+	if v, ok := m["account"].(*schema.Set); ok && v.Len() > 0 {
+		scope[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	return scope
+}
+
+func expandFmsPolicyResourceTags(m map[string]interface{}) []*fms.ResourceTag {
+	if len(m) == 0 {
+		return nil
+	}
+
+	tags := make([]*fms.ResourceTag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &fms.ResourceTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func expandFmsSecurityServicePolicyData(l []interface{}) *fms.SecurityServicePolicyData {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	data := &fms.SecurityServicePolicyData{
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["managed_service_data"].(string); ok && v != "" {
+		data.ManagedServiceData = aws.String(v)
+	}
+
+	return data
+}
+
+func flattenFmsPolicyScope(scope map[string][]*string) []interface{} {
+	if len(scope) == 0 {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"account": flattenStringSet(scope[fms.CustomerPolicyScopeIdTypeAccount]),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenFmsPolicyResourceTags(tags []*fms.ResourceTag) map[string]string {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func flattenFmsSecurityServicePolicyData(data *fms.SecurityServicePolicyData) []interface{} {
+	if data == nil {
+		return []interface{}{}
+	}
+
+	managedServiceData := aws.StringValue(data.ManagedServiceData)
+	if v, err := structure.NormalizeJsonString(managedServiceData); err == nil {
+		managedServiceData = v
+	}
+
+	m := map[string]interface{}{
+		"managed_service_data": managedServiceData,
+		"type":                 aws.StringValue(data.Type),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFmsPolicy_basic(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var policy fms.Policy
+	resourceName := "aws_fms_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeWaf),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_update_token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_update(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var policy fms.Policy
+	resourceName := "aws_fms_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_map.0.account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "2"),
+				),
+			},
+			{
+				Config: testAccFmsPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_missingResourceType(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFmsPolicyConfig_missingResourceType(rName),
+				ExpectError: regexp.MustCompile("one of `resource_type` or `resource_type_list` must be set"),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		_, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsFmsPolicyExists(resourceName string, policy *fms.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*policy = *output.Policy
+
+		return nil
+	}
+}
+
+// The FMS administrator account must already be associated with the
+// organization master account, e.g. via aws_fms_admin_account.
+func testAccFmsPolicyConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [], \"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = true
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type_list    = ["AWS::ElasticLoadBalancingV2::LoadBalancer", "AWS::CloudFront::Distribution"]
+
+  exclude_map {
+    account = ["${data.aws_caller_identity.current.account_id}"]
+  }
+
+  resource_tags = {
+    Environment = "test"
+  }
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [], \"defaultAction\": {\"type\": \"ALLOW\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName)
+}
+
+func testAccFmsPolicyConfig_missingResourceType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+
+  security_service_policy_data {
+    type                 = "WAF"
+    managed_service_data = "{\"type\": \"WAF\", \"ruleGroups\": [], \"defaultAction\": {\"type\": \"BLOCK\"}, \"overrideCustomerWebACLAssociation\": false}"
+  }
+}
+`, rName)
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The data catalog encryption settings are account-wide, so the tests must not run in parallel.
+func TestAccAWSGlueDataCatalogEncryptionSettings_basic(t *testing.T) {
+	var settings glue.DataCatalogEncryptionSettings
+
+	resourceName := "aws_glue_data_catalog_encryption_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfig_Disabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.return_connection_password_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueDataCatalogEncryptionSettingsConfig_Enabled,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName, &settings),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.return_connection_password_encrypted", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.connection_password_encryption.0.aws_kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.catalog_encryption_mode", "SSE-KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_catalog_encryption_settings.0.encryption_at_rest.0.sse_aws_kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsExists(resourceName string, settings *glue.DataCatalogEncryptionSettings) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Data Catalog Encryption Settings ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetDataCatalogEncryptionSettings(&glue.GetDataCatalogEncryptionSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if output.DataCatalogEncryptionSettings == nil {
+			return fmt.Errorf("Glue Data Catalog Encryption Settings (%s) not found", rs.Primary.ID)
+		}
+
+		*settings = *output.DataCatalogEncryptionSettings
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueDataCatalogEncryptionSettingsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_data_catalog_encryption_settings" {
+			continue
+		}
+
+		output, err := conn.GetDataCatalogEncryptionSettings(&glue.GetDataCatalogEncryptionSettingsInput{
+			CatalogId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if settings := output.DataCatalogEncryptionSettings; settings != nil {
+			if settings.EncryptionAtRest != nil && aws.StringValue(settings.EncryptionAtRest.CatalogEncryptionMode) != glue.CatalogEncryptionModeDisabled {
+				return fmt.Errorf("Glue Data Catalog Encryption Settings (%s) still enabled", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccAWSGlueDataCatalogEncryptionSettingsConfig_Disabled = `
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    connection_password_encryption {
+      return_connection_password_encrypted = false
+    }
+
+    encryption_at_rest {
+      catalog_encryption_mode = "DISABLED"
+    }
+  }
+}
+`
+
+const testAccAWSGlueDataCatalogEncryptionSettingsConfig_Enabled = `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_glue_data_catalog_encryption_settings" "test" {
+  data_catalog_encryption_settings {
+    connection_password_encryption {
+      aws_kms_key_id                       = "${aws_kms_key.test.arn}"
+      return_connection_password_encrypted = true
+    }
+
+    encryption_at_rest {
+      catalog_encryption_mode = "SSE-KMS"
+      sse_aws_kms_key_id      = "${aws_kms_key.test.arn}"
+    }
+  }
+}
+`
@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueMLTransform_basic(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_ml_transform.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfig_basic(rName, "First Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+					resource.TestCheckResourceAttr(resourceName, "input_record_tables.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "label_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.transform_type", "FIND_MATCHES"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.0.primary_key_column_name", "my_column_1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfig_basic(rName, "Second Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueMLTransformExists(resourceName string, transform *glue.GetMLTransformOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue ML Transform ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetMLTransform(&glue.GetMLTransformInput{
+			TransformId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*transform = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueMLTransformDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_ml_transform" {
+			continue
+		}
+
+		output, err := conn.GetMLTransform(&glue.GetMLTransformInput{
+			TransformId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.TransformId) == rs.Primary.ID {
+			return fmt.Errorf("Glue ML Transform (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSGlueMLTransformConfig_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[2]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[2]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+
+  storage_descriptor {
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+
+resource "aws_glue_ml_transform" "test" {
+  description = %[3]q
+  name        = %[2]q
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  input_record_tables {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    table_name    = "${aws_glue_catalog_table.test.name}"
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy_attachment.test"]
+}
+`, testAccAWSGlueJobConfig_Base(rName), rName, description)
+}
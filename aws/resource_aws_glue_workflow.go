@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsGlueWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueWorkflowCreate,
+		Read:   resourceAwsGlueWorkflowRead,
+		Update: resourceAwsGlueWorkflowUpdate,
+		Delete: resourceAwsGlueWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_run_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsGlueWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateWorkflowInput{
+		Name: aws.String(name),
+		Tags: keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().GlueTags(),
+	}
+
+	if v, ok := d.GetOk("default_run_properties"); ok {
+		input.DefaultRunProperties = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Workflow: %s", input)
+	if _, err := conn.CreateWorkflow(input); err != nil {
+		return fmt.Errorf("error creating Glue Workflow (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsGlueWorkflowRead(d, meta)
+}
+
+func resourceAwsGlueWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Workflow (%s): %s", d.Id(), err)
+	}
+
+	workflow := output.Workflow
+	if workflow == nil {
+		log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	workflowARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "glue",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("workflow/%s", d.Id()),
+	}.String()
+	d.Set("arn", workflowARN)
+
+	if err := d.Set("default_run_properties", aws.StringValueMap(workflow.DefaultRunProperties)); err != nil {
+		return fmt.Errorf("error setting default_run_properties: %s", err)
+	}
+
+	d.Set("description", workflow.Description)
+	d.Set("name", workflow.Name)
+
+	tags, err := keyvaluetags.GlueListTags(conn, workflowARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Workflow (%s): %s", workflowARN, err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChange("default_run_properties") || d.HasChange("description") {
+		input := &glue.UpdateWorkflowInput{
+			DefaultRunProperties: stringMapToPointers(d.Get("default_run_properties").(map[string]interface{})),
+			Description:          aws.String(d.Get("description").(string)),
+			Name:                 aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Glue Workflow: %s", input)
+		if _, err := conn.UpdateWorkflow(input); err != nil {
+			return fmt.Errorf("error updating Glue Workflow (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Glue Workflow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueWorkflowRead(d, meta)
+}
+
+func resourceAwsGlueWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Workflow: %s", d.Id())
+	_, err := conn.DeleteWorkflow(&glue.DeleteWorkflowInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Workflow (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,394 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsGuardDutyFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyFilterCreate,
+		Read:   resourceAwsGuardDutyFilterRead,
+		Update: resourceAwsGuardDutyFilterUpdate,
+		Delete: resourceAwsGuardDutyFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.FilterActionArchive,
+					guardduty.FilterActionNoop,
+				}, false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"greater_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionNumber,
+									},
+									"greater_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionNumber,
+									},
+									"less_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionNumber,
+									},
+									"less_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionNumber,
+									},
+									"not_equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"rank": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+var validateGuardDutyFilterConditionNumber = validation.StringMatch(regexp.MustCompile(`^-?[0-9]+$`), "must be an integer")
+
+func resourceAwsGuardDutyFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+	detectorID := d.Get("detector_id").(string)
+	name := d.Get("name").(string)
+
+	findingCriteria, err := expandGuardDutyFilterFindingCriteria(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.CreateFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		DetectorId:      aws.String(detectorID),
+		FindingCriteria: findingCriteria,
+		Name:            aws.String(name),
+		Rank:            aws.Int64(int64(d.Get("rank").(int))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().GuarddutyTags()
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Filter: %s", input)
+	_, err = conn.CreateFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating GuardDuty Filter (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, name))
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetFilter(&guardduty.GetFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	})
+
+	if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") ||
+		isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+		log.Printf("[WARN] GuardDuty Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	filterArn := arn.ARN{
+		AccountID: meta.(*AWSClient).accountid,
+		Partition: meta.(*AWSClient).partition,
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("detector/%s/filter/%s", detectorID, name),
+		Service:   "guardduty",
+	}.String()
+
+	d.Set("action", output.Action)
+	d.Set("arn", filterArn)
+	d.Set("description", output.Description)
+	d.Set("detector_id", detectorID)
+	d.Set("name", output.Name)
+	d.Set("rank", output.Rank)
+
+	if err := d.Set("finding_criteria", flattenGuardDutyFilterFindingCriteria(output.FindingCriteria)); err != nil {
+		return fmt.Errorf("error setting finding_criteria: %s", err)
+	}
+
+	if err := setTagsAll(d, meta, keyvaluetags.GuarddutyKeyValueTags(output.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("action") || d.HasChange("description") || d.HasChange("finding_criteria") || d.HasChange("rank") {
+		findingCriteria, err := expandGuardDutyFilterFindingCriteria(d.Get("finding_criteria").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		input := &guardduty.UpdateFilterInput{
+			Action:          aws.String(d.Get("action").(string)),
+			Description:     aws.String(d.Get("description").(string)),
+			DetectorId:      aws.String(detectorID),
+			FilterName:      aws.String(name),
+			FindingCriteria: findingCriteria,
+			Rank:            aws.Int64(int64(d.Get("rank").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating GuardDuty Filter: %s", input)
+		if _, err := conn.UpdateFilter(input); err != nil {
+			return fmt.Errorf("error updating GuardDuty Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.GuarddutyUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating GuardDuty Filter (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Filter: %s", input)
+	_, err = conn.DeleteFilter(input)
+
+	if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeGuardDutyFilterID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("GuardDuty Filter ID must be of the form <Detector ID>:<Filter Name>, was provided: %s", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func expandGuardDutyFilterFindingCriteria(l []interface{}) (*guardduty.FindingCriteria, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	criteria := m["criterion"].(*schema.Set).List()
+
+	findingCriteria := &guardduty.FindingCriteria{
+		Criterion: make(map[string]*guardduty.Condition, len(criteria)),
+	}
+
+	for _, raw := range criteria {
+		criterion, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		field := criterion["field"].(string)
+		condition := &guardduty.Condition{}
+
+		if v, ok := criterion["equals"].([]interface{}); ok && len(v) > 0 {
+			condition.Eq = expandStringList(v)
+		}
+
+		if v, ok := criterion["not_equals"].([]interface{}); ok && len(v) > 0 {
+			condition.Neq = expandStringList(v)
+		}
+
+		for key, target := range map[string]**int64{
+			"greater_than":          &condition.Gt,
+			"greater_than_or_equal": &condition.Gte,
+			"less_than":             &condition.Lt,
+			"less_than_or_equal":    &condition.Lte,
+		} {
+			v, ok := criterion[key].(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing GuardDuty Filter criterion (%s) %s value (%s): %s", field, key, v, err)
+			}
+
+			*target = aws.Int64(i)
+		}
+
+		findingCriteria.Criterion[field] = condition
+	}
+
+	return findingCriteria, nil
+}
+
+func flattenGuardDutyFilterFindingCriteria(findingCriteria *guardduty.FindingCriteria) []interface{} {
+	if findingCriteria == nil {
+		return []interface{}{}
+	}
+
+	criteria := make([]interface{}, 0, len(findingCriteria.Criterion))
+
+	for field, condition := range findingCriteria.Criterion {
+		if condition == nil {
+			continue
+		}
+
+		criterion := map[string]interface{}{
+			"field": field,
+		}
+
+		if len(condition.Eq) > 0 {
+			criterion["equals"] = flattenStringList(condition.Eq)
+		}
+
+		if len(condition.Neq) > 0 {
+			criterion["not_equals"] = flattenStringList(condition.Neq)
+		}
+
+		if condition.Gt != nil {
+			criterion["greater_than"] = strconv.FormatInt(aws.Int64Value(condition.Gt), 10)
+		}
+
+		if condition.Gte != nil {
+			criterion["greater_than_or_equal"] = strconv.FormatInt(aws.Int64Value(condition.Gte), 10)
+		}
+
+		if condition.Lt != nil {
+			criterion["less_than"] = strconv.FormatInt(aws.Int64Value(condition.Lt), 10)
+		}
+
+		if condition.Lte != nil {
+			criterion["less_than_or_equal"] = strconv.FormatInt(aws.Int64Value(condition.Lte), 10)
+		}
+
+		criteria = append(criteria, criterion)
+	}
+
+	m := map[string]interface{}{
+		"criterion": criteria,
+	}
+
+	return []interface{}{m}
+}
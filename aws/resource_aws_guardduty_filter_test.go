@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAwsGuardDutyFilter_basic(t *testing.T) {
+	var filter guardduty.GetFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_guardduty_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig_basic(rName, "ARCHIVE", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "action", "ARCHIVE"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "guardduty", regexp.MustCompile(fmt.Sprintf("detector/.+/filter/%s$", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyFilter_update(t *testing.T) {
+	var filter guardduty.GetFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_guardduty_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig_basic(rName, "ARCHIVE", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+				),
+			},
+			{
+				Config: testAccGuardDutyFilterConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "action", "NOOP"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyFilter_tags(t *testing.T) {
+	var filter guardduty.GetFilterOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_guardduty_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyFilterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGuardDutyFilterConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName, &filter),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyFilterExists(resourceName string, filter *guardduty.GetFilterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Filter ID is set")
+		}
+
+		detectorID, name, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+		output, err := conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*filter = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsGuardDutyFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_filter" {
+			continue
+		}
+
+		detectorID, name, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(name),
+		})
+
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") ||
+			isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GuardDuty Filter (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGuardDutyFilterConfig_basic(rName, action string, rank int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_filter" "test" {
+  action      = %[2]q
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = %[3]d
+
+  finding_criteria {
+    criterion {
+      field  = "region"
+      equals = ["${data.aws_region.current.name}"]
+    }
+
+    criterion {
+      field      = "service.additionalInfo.threatListName"
+      not_equals = ["some-threat", "another-threat"]
+    }
+
+    criterion {
+      field                 = "severity"
+      greater_than_or_equal = "4"
+    }
+  }
+}
+`, rName, action, rank)
+}
+
+func testAccGuardDutyFilterConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_filter" "test" {
+  action      = "NOOP"
+  description = "updated"
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = 2
+
+  finding_criteria {
+    criterion {
+      field      = "service.additionalInfo.threatListName"
+      not_equals = ["some-threat"]
+    }
+
+    criterion {
+      field        = "updatedAt"
+      greater_than = "1577836800000"
+      less_than    = "1893456000000"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccGuardDutyFilterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_filter" "test" {
+  action      = "ARCHIVE"
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = 1
+
+  finding_criteria {
+    criterion {
+      field                 = "severity"
+      greater_than_or_equal = "4"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccGuardDutyFilterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  enable = true
+}
+
+resource "aws_guardduty_filter" "test" {
+  action      = "ARCHIVE"
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = 1
+
+  finding_criteria {
+    criterion {
+      field                 = "severity"
+      greater_than_or_equal = "4"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -11,6 +11,11 @@ func TestAccAWSGuardDuty(t *testing.T) {
 			"basic":  testAccAwsGuardDutyDetector_basic,
 			"import": testAccAwsGuardDutyDetector_import,
 		},
+		"Filter": {
+			"basic":  testAccAwsGuardDutyFilter_basic,
+			"update": testAccAwsGuardDutyFilter_update,
+			"tags":   testAccAwsGuardDutyFilter_tags,
+		},
 		"InviteAccepter": {
 			"basic": testAccAwsGuardDutyInviteAccepter_basic,
 		},
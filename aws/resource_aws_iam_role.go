@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsIamRole() *schema.Resource {
@@ -25,6 +26,8 @@ func resourceAwsIamRole() *schema.Resource {
 			State: resourceAwsIamRoleImport,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -121,7 +124,8 @@ func resourceAwsIamRole() *schema.Resource {
 				ValidateFunc: validation.IntBetween(3600, 43200),
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -162,8 +166,8 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		request.PermissionsBoundary = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tagsFromMapIAM(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		request.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().IamTags()
 	}
 
 	var createResp *iam.CreateRoleOutput
@@ -189,6 +193,7 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	request := &iam.GetRoleInput{
 		RoleName: aws.String(d.Id()),
@@ -224,7 +229,7 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("permissions_boundary", role.PermissionsBoundary.PermissionsBoundaryArn)
 	}
 	d.Set("unique_id", role.RoleId)
-	if err := d.Set("tags", tagsToMapIAM(role.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.IamKeyValueTags(role.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -308,32 +313,11 @@ func resourceAwsIamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("tags") {
-		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		c, r := diffTagsIAM(tagsFromMapIAM(o), tagsFromMapIAM(n))
-
-		if len(r) > 0 {
-			_, err := iamconn.UntagRole(&iam.UntagRoleInput{
-				RoleName: aws.String(d.Id()),
-				TagKeys:  tagKeysIam(r),
-			})
-			if err != nil {
-				return fmt.Errorf("error deleting IAM role tags: %s", err)
-			}
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(c) > 0 {
-			input := &iam.TagRoleInput{
-				RoleName: aws.String(d.Id()),
-				Tags:     c,
-			}
-			_, err := iamconn.TagRole(input)
-			if err != nil {
-				return fmt.Errorf("error update IAM role tags: %s", err)
-			}
+		if err := keyvaluetags.IamRoleUpdateTags(iamconn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating IAM Role (%s) tags: %s", d.Id(), err)
 		}
 	}
 
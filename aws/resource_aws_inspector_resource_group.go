@@ -33,7 +33,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 	conn := meta.(*AWSClient).inspectorconn
 
 	resp, err := conn.CreateResourceGroup(&inspector.CreateResourceGroupInput{
-		ResourceGroupTags: tagsFromMapInspector(d.Get("tags").(map[string]interface{})),
+		ResourceGroupTags: expandInspectorResourceGroupTags(d.Get("tags").(map[string]interface{})),
 	})
 
 	if err != nil {
@@ -71,3 +71,16 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 func resourceAwsInspectorResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
+
+func expandInspectorResourceGroupTags(m map[string]interface{}) []*inspector.ResourceGroupTag {
+	var result []*inspector.ResourceGroupTag
+
+	for k, v := range m {
+		result = append(result, &inspector.ResourceGroupTag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return result
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -40,6 +41,8 @@ func resourceAwsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
@@ -306,7 +309,8 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"volume_tags": tagsSchemaComputed(),
 
@@ -572,8 +576,8 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	tagsSpec := make([]*ec2.TagSpecification, 0)
 
-	if v, ok := d.GetOk("tags"); ok {
-		tags := tagsFromMap(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().Ec2Tags()
 
 		spec := &ec2.TagSpecification{
 			ResourceType: aws.String("instance"),
@@ -584,7 +588,7 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("volume_tags"); ok {
-		tags := tagsFromMap(v.(map[string]interface{}))
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().Ec2Tags()
 
 		spec := &ec2.TagSpecification{
 			ResourceType: aws.String("volume"),
@@ -823,9 +827,11 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(instance.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
-	if err := readVolumeTags(conn, d); err != nil {
+	if err := readVolumeTags(conn, d, meta.(*AWSClient).ignoreTagsConfig); err != nil {
 		return err
 	}
 
@@ -919,11 +925,14 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	d.Partial(true)
 
-	if d.HasChange("tags") && !d.IsNewResource() {
-		if err := setTags(conn, d); err != nil {
+	if d.HasChange("tags_all") && !d.IsNewResource() {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 	if d.HasChange("volume_tags") && !d.IsNewResource() {
 		if err := setVolumeTags(conn, d); err != nil {
@@ -1626,7 +1635,7 @@ func readBlockDeviceMappingsFromConfig(
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
+func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData, ignoreTagsConfig *keyvaluetags.IgnoreConfig) error {
 	volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 	if err != nil {
 		return err
@@ -1654,7 +1663,7 @@ func readVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 		tags = append(tags, tag)
 	}
 
-	d.Set("volume_tags", tagsToMap(tags))
+	d.Set("volume_tags", keyvaluetags.Ec2KeyValueTags(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map())
 
 	return nil
 }
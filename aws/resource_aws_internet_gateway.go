@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsInternetGateway() *schema.Resource {
@@ -22,12 +23,15 @@ func resourceAwsInternetGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,9 +77,10 @@ func resourceAwsInternetGatewayCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error refreshing internet gateway state: %s", err)
 	}
 
-	err = setTags(conn, d)
-	if err != nil {
-		return err
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), nil, v); err != nil {
+			return fmt.Errorf("error adding EC2 Internet Gateway (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	// Attach the new gateway to the correct vpc
@@ -108,7 +113,9 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(ig.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 	d.Set("owner_id", ig.OwnerId)
 
 	return nil
@@ -129,11 +136,16 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 
 	conn := meta.(*AWSClient).ec2conn
 
-	if err := setTags(conn, d); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsInternetGatewayRead(d, meta)
 }
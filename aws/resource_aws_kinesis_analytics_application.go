@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
@@ -30,6 +31,8 @@ func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -552,7 +555,8 @@ func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -589,8 +593,8 @@ func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta i
 		createOpts.Outputs = outputs
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		createOpts.Tags = tagsFromMapKinesisAnalytics(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		createOpts.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().KinesisanalyticsTags()
 	}
 
 	// Retry for IAM eventual consistency
@@ -630,6 +634,7 @@ func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta i
 
 func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kinesisanalyticsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	name := d.Get("name").(string)
 
 	describeOpts := &kinesisanalytics.DescribeApplicationInput{
@@ -670,7 +675,13 @@ func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta int
 		return fmt.Errorf("error setting reference_data_sources: %s", err)
 	}
 
-	if err := getTagsKinesisAnalytics(conn, d); err != nil {
+	tags, err := keyvaluetags.KinesisanalyticsListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -811,8 +822,12 @@ func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta i
 			}
 		}
 
-		if err := setTagsKinesisAnalytics(conn, d); err != nil {
-			return fmt.Errorf("Error update resource tags for %s: %s", d.Id(), err)
+		if d.HasChange("tags_all") {
+			o, n := d.GetChange("tags_all")
+
+			if err := keyvaluetags.KinesisanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+				return fmt.Errorf("Error update resource tags for %s: %s", d.Id(), err)
+			}
 		}
 
 	}
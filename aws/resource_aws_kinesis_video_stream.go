@@ -0,0 +1,315 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsKinesisVideoStream() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisVideoStreamCreate,
+		Read:   resourceAwsKinesisVideoStreamRead,
+		Update: resourceAwsKinesisVideoStreamUpdate,
+		Delete: resourceAwsKinesisVideoStreamDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_retention_in_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"device_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"media_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisVideoStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+	name := d.Get("name").(string)
+
+	input := &kinesisvideo.CreateStreamInput{
+		DataRetentionInHours: aws.Int64(int64(d.Get("data_retention_in_hours").(int))),
+		StreamName:           aws.String(name),
+		Tags:                 keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().KinesisvideoTags(),
+	}
+
+	if v, ok := d.GetOk("device_name"); ok {
+		input.DeviceName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("media_type"); ok {
+		input.MediaType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Video Stream: %s", input)
+	output, err := conn.CreateStream(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Video Stream (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StreamARN))
+
+	if err := waitForKinesisVideoStreamStatus(conn, d.Id(), []string{kinesisvideo.StatusCreating}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Video Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	stream := output.StreamInfo
+
+	if stream == nil {
+		log.Printf("[WARN] Kinesis Video Stream (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", stream.StreamARN)
+	d.Set("creation_time", aws.TimeValue(stream.CreationTime).Format(time.RFC3339))
+	d.Set("data_retention_in_hours", stream.DataRetentionInHours)
+	d.Set("device_name", stream.DeviceName)
+	d.Set("kms_key_id", stream.KmsKeyId)
+	d.Set("media_type", stream.MediaType)
+	d.Set("name", stream.StreamName)
+	d.Set("version", stream.Version)
+
+	tags, err := keyvaluetags.KinesisvideoListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisVideoStreamUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	if d.HasChange("device_name") || d.HasChange("media_type") {
+		input := &kinesisvideo.UpdateStreamInput{
+			CurrentVersion: aws.String(d.Get("version").(string)),
+			StreamARN:      aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("device_name"); ok {
+			input.DeviceName = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("media_type"); ok {
+			input.MediaType = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream: %s", input)
+		if _, err := conn.UpdateStream(input); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForKinesisVideoStreamStatus(conn, d.Id(), []string{kinesisvideo.StatusUpdating}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Video Stream (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("data_retention_in_hours") {
+		o, n := d.GetChange("data_retention_in_hours")
+		oldRetention, newRetention := o.(int), n.(int)
+
+		// The stream version changes after every update, so refresh it before changing retention.
+		output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+			StreamARN: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading Kinesis Video Stream (%s): %s", d.Id(), err)
+		}
+
+		input := &kinesisvideo.UpdateDataRetentionInput{
+			CurrentVersion: output.StreamInfo.Version,
+			StreamARN:      aws.String(d.Id()),
+		}
+
+		if newRetention > oldRetention {
+			input.DataRetentionChangeInHours = aws.Int64(int64(newRetention - oldRetention))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationIncreaseDataRetention)
+		} else {
+			input.DataRetentionChangeInHours = aws.Int64(int64(oldRetention - newRetention))
+			input.Operation = aws.String(kinesisvideo.UpdateDataRetentionOperationDecreaseDataRetention)
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Video Stream data retention: %s", input)
+		if _, err := conn.UpdateDataRetention(input); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) data retention: %s", d.Id(), err)
+		}
+
+		if err := waitForKinesisVideoStreamStatus(conn, d.Id(), []string{kinesisvideo.StatusUpdating}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Video Stream (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.KinesisvideoUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Video Stream (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisVideoStreamRead(d, meta)
+}
+
+func resourceAwsKinesisVideoStreamDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisvideoconn
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream: %s", d.Id())
+	_, err := conn.DeleteStream(&kinesisvideo.DeleteStreamInput{
+		CurrentVersion: aws.String(d.Get("version").(string)),
+		StreamARN:      aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Video Stream (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisVideoStreamDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Video Stream (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func kinesisVideoStreamStatusRefreshFunc(conn *kinesisvideo.KinesisVideo, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+			StreamARN: aws.String(arn),
+		})
+
+		if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.StreamInfo == nil {
+			return nil, "", nil
+		}
+
+		return output.StreamInfo, aws.StringValue(output.StreamInfo.Status), nil
+	}
+}
+
+func waitForKinesisVideoStreamStatus(conn *kinesisvideo.KinesisVideo, arn string, pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{kinesisvideo.StatusActive},
+		Refresh:    kinesisVideoStreamStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKinesisVideoStreamDeletion(conn *kinesisvideo.KinesisVideo, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesisvideo.StatusDeleting},
+		Target:     []string{},
+		Refresh:    kinesisVideoStreamStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKinesisVideoStream_basic(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("kinesisvideo", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisVideoStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesisvideo", regexp.MustCompile(fmt.Sprintf("stream/%s/.+", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_Options(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+
+	resourceName := "aws_kinesis_video_stream.test"
+	kmsResourceName := "aws_kms_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("kinesisvideo", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisVideoStreamConfig_Options(rName, "video/h264", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_name", "kinesis-video-device-name"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsResourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h264"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisVideoStreamConfig_Options(rName, "video/h120", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_in_hours", "2"),
+					resource.TestCheckResourceAttr(resourceName, "media_type", "video/h120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisVideoStream_Tags(t *testing.T) {
+	var stream kinesisvideo.StreamInfo
+
+	resourceName := "aws_kinesis_video_stream.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("kinesisvideo", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisVideoStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisVideoStreamConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKinesisVideoStreamConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccKinesisVideoStreamConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisVideoStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKinesisVideoStreamExists(n string, stream *kinesisvideo.StreamInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Video Stream ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+		output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+			StreamARN: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*stream = *output.StreamInfo
+
+		return nil
+	}
+}
+
+func testAccCheckKinesisVideoStreamDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisvideoconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_video_stream" {
+			continue
+		}
+
+		output, err := conn.DescribeStream(&kinesisvideo.DescribeStreamInput{
+			StreamARN: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.StreamInfo != nil && aws.StringValue(output.StreamInfo.Status) != kinesisvideo.StatusDeleting {
+			return fmt.Errorf("Kinesis Video Stream (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccKinesisVideoStreamConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccKinesisVideoStreamConfig_Options(rName, mediaType string, retention int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  data_retention_in_hours = %[3]d
+  device_name             = "kinesis-video-device-name"
+  kms_key_id              = "${aws_kms_key.test.key_id}"
+  media_type              = %[2]q
+}
+`, rName, mediaType, retention)
+}
+
+func testAccKinesisVideoStreamConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccKinesisVideoStreamConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,1175 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.text_content"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"text_content": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ValidateFunc:  validation.StringLenBetween(0, 102400),
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location"},
+												},
+											},
+										},
+									},
+									"code_content_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalyticsv2.CodeContentTypePlaintext,
+											kinesisanalyticsv2.CodeContentTypeZipfile,
+										}, false),
+									},
+								},
+							},
+						},
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateKinesisAnalyticsV2ConfigurationType,
+												},
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateKinesisAnalyticsV2ConfigurationType,
+												},
+												"log_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.LogLevelDebug,
+														kinesisanalyticsv2.LogLevelError,
+														kinesisanalyticsv2.LogLevelInfo,
+														kinesisanalyticsv2.LogLevelWarn,
+													}, false),
+												},
+												"metrics_level": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.MetricsLevelApplication,
+														kinesisanalyticsv2.MetricsLevelOperator,
+														kinesisanalyticsv2.MetricsLevelParallelism,
+														kinesisanalyticsv2.MetricsLevelTask,
+													}, false),
+												},
+											},
+										},
+									},
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateKinesisAnalyticsV2ConfigurationType,
+												},
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromCustomSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeRestoreFromLatestSnapshot,
+														kinesisanalyticsv2.ApplicationRestoreTypeSkipRestoreFromSnapshot,
+													}, false),
+												},
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_option_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"runtime_environment": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					kinesisanalyticsv2.RuntimeEnvironmentFlink16,
+				}, false),
+			},
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+var validateKinesisAnalyticsV2ConfigurationType = validation.StringInSlice([]string{
+	kinesisanalyticsv2.ConfigurationTypeCustom,
+	kinesisanalyticsv2.ConfigurationTypeDefault,
+}, false)
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Kinesis Analytics v2 Application ARN (%s): %s", d.Id(), err)
+	}
+
+	d.Set("name", strings.TrimPrefix(applicationARN.Resource, "application/"))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationDescription:   aws.String(d.Get("description").(string)),
+		ApplicationName:          aws.String(name),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+		Tags:                     keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().Kinesisanalyticsv2Tags(),
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+	var output *kinesisanalyticsv2.CreateApplicationOutput
+	// Retry for IAM eventual consistency
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateApplication(input)
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		output, err = conn.CreateApplication(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
+
+	if d.Get("start_application").(bool) {
+		runConfiguration := expandKinesisAnalyticsV2RunConfiguration(d.Get("application_configuration").([]interface{}))
+
+		if err := startKinesisAnalyticsV2Application(conn, name, runConfiguration, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Get("name").(string)),
+	})
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	application := output.ApplicationDetail
+	if application == nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): empty response", d.Id())
+	}
+
+	status := aws.StringValue(application.ApplicationStatus)
+
+	d.Set("arn", application.ApplicationARN)
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("start_application", status == kinesisanalyticsv2.ApplicationStatusRunning || status == kinesisanalyticsv2.ApplicationStatusStarting)
+	d.Set("status", status)
+	d.Set("version_id", application.ApplicationVersionId)
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfigurationDescription(application.ApplicationConfigurationDescription)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %s", err)
+	}
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %s", err)
+	}
+
+	tags, err := keyvaluetags.Kinesisanalyticsv2ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+	currentVersionID := int64(d.Get("version_id").(int))
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	// Logging options are added and removed through their own operations.
+	// Changes to an existing logging option are made as part of the application update below.
+	var cloudWatchLoggingOptionUpdates []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+
+		switch oldOptions, newOptions := o.([]interface{}), n.([]interface{}); {
+		case len(oldOptions) == 0:
+			input := &kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(newOptions)[0],
+				CurrentApplicationVersionId: aws.Int64(currentVersionID),
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application CloudWatch logging option: %s", input)
+			output, err := conn.AddApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			currentVersionID = aws.Int64Value(output.ApplicationVersionId)
+
+		case len(newOptions) == 0:
+			input := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(name),
+				CloudWatchLoggingOptionId:   aws.String(oldOptions[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(currentVersionID),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application CloudWatch logging option: %s", input)
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), err)
+			}
+
+			currentVersionID = aws.Int64Value(output.ApplicationVersionId)
+
+		default:
+			cloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(oldOptions[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+					LogStreamARNUpdate:        aws.String(newOptions[0].(map[string]interface{})["log_stream_arn"].(string)),
+				},
+			}
+		}
+
+		if err := waitForKinesisAnalyticsV2ApplicationUpdate(conn, name, timeout); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("application_configuration") || d.HasChange("service_execution_role") || len(cloudWatchLoggingOptionUpdates) > 0 {
+		input := &kinesisanalyticsv2.UpdateApplicationInput{
+			ApplicationName:                aws.String(name),
+			CloudWatchLoggingOptionUpdates: cloudWatchLoggingOptionUpdates,
+			CurrentApplicationVersionId:    aws.Int64(currentVersionID),
+		}
+
+		if d.HasChange("application_configuration") {
+			input.ApplicationConfigurationUpdate = expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d)
+
+			// The run configuration only applies to running applications.
+			if d.HasChange("application_configuration.0.run_configuration") && !d.HasChange("start_application") && d.Get("start_application").(bool) {
+				if runConfiguration := expandKinesisAnalyticsV2RunConfiguration(d.Get("application_configuration").([]interface{})); runConfiguration != nil {
+					input.RunConfigurationUpdate = &kinesisanalyticsv2.RunConfigurationUpdate{
+						ApplicationRestoreConfiguration: runConfiguration.ApplicationRestoreConfiguration,
+					}
+				}
+			}
+		}
+
+		if d.HasChange("service_execution_role") {
+			input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application: %s", input)
+		if _, err := conn.UpdateApplication(input); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForKinesisAnalyticsV2ApplicationUpdate(conn, name, timeout); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			runConfiguration := expandKinesisAnalyticsV2RunConfiguration(d.Get("application_configuration").([]interface{}))
+
+			if err := startKinesisAnalyticsV2Application(conn, name, runConfiguration, timeout); err != nil {
+				return err
+			}
+		} else {
+			if err := stopKinesisAnalyticsV2Application(conn, name, timeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Kinesisanalyticsv2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	name := d.Get("name").(string)
+
+	createTimestamp, err := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing Kinesis Analytics v2 Application (%s) create_timestamp: %s", d.Id(), err)
+	}
+
+	input := &kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(name),
+		CreateTimestamp: aws.Time(createTimestamp),
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application: %s", input)
+	_, err = conn.DeleteApplication(input)
+
+	if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationDeletion(conn, name, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startKinesisAnalyticsV2Application(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, runConfiguration *kinesisanalyticsv2.RunConfiguration, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName:  aws.String(name),
+		RunConfiguration: runConfiguration,
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusReady, kinesisanalyticsv2.ApplicationStatusStarting}, kinesisanalyticsv2.ApplicationStatusRunning, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %s", name, err)
+	}
+
+	return nil
+}
+
+func stopKinesisAnalyticsV2Application(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	input := &kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application: %s", input)
+	if _, err := conn.StopApplication(input); err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %s", name, err)
+	}
+
+	if err := waitForKinesisAnalyticsV2ApplicationStatus(conn, name, []string{kinesisanalyticsv2.ApplicationStatusRunning, kinesisanalyticsv2.ApplicationStatusStopping}, kinesisanalyticsv2.ApplicationStatusReady, timeout); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %s", name, err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2ApplicationStatusRefreshFunc(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeApplication(&kinesisanalyticsv2.DescribeApplicationInput{
+			ApplicationName: aws.String(name),
+		})
+
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.ApplicationDetail == nil {
+			return nil, "", nil
+		}
+
+		return output.ApplicationDetail, aws.StringValue(output.ApplicationDetail.ApplicationStatus), nil
+	}
+}
+
+// waitForKinesisAnalyticsV2ApplicationStatus waits for a start or stop to
+// finish. The pending statuses include the status before the transition, as
+// the application may not have left it yet when the wait begins.
+func waitForKinesisAnalyticsV2ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    kinesisAnalyticsV2ApplicationStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Kinesis Analytics v2 Application (%s) to become %s", name, target)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// waitForKinesisAnalyticsV2ApplicationUpdate waits for an update to finish,
+// leaving the application in its previous READY or RUNNING status.
+func waitForKinesisAnalyticsV2ApplicationUpdate(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kinesisanalyticsv2.ApplicationStatusUpdating},
+		Target: []string{
+			kinesisanalyticsv2.ApplicationStatusReady,
+			kinesisanalyticsv2.ApplicationStatusRunning,
+		},
+		Refresh:    kinesisAnalyticsV2ApplicationStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Kinesis Analytics v2 Application (%s) update", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKinesisAnalyticsV2ApplicationDeletion(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			kinesisanalyticsv2.ApplicationStatusDeleting,
+			kinesisanalyticsv2.ApplicationStatusReady,
+			kinesisanalyticsv2.ApplicationStatusRunning,
+			kinesisanalyticsv2.ApplicationStatusStopping,
+		},
+		Target:     []string{},
+		Refresh:    kinesisAnalyticsV2ApplicationStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Kinesis Analytics v2 Application (%s) deletion", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(l []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{}
+
+	if v, ok := m["application_code_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mCode := v[0].(map[string]interface{})
+
+		applicationConfiguration.ApplicationCodeConfiguration = &kinesisanalyticsv2.ApplicationCodeConfiguration{
+			CodeContentType: aws.String(mCode["code_content_type"].(string)),
+		}
+
+		if v, ok := mCode["code_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mContent := v[0].(map[string]interface{})
+			codeContent := &kinesisanalyticsv2.CodeContent{}
+
+			if v, ok := mContent["s3_content_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				mLocation := v[0].(map[string]interface{})
+
+				codeContent.S3ContentLocation = &kinesisanalyticsv2.S3ContentLocation{
+					BucketARN: aws.String(mLocation["bucket_arn"].(string)),
+					FileKey:   aws.String(mLocation["file_key"].(string)),
+				}
+
+				if v, ok := mLocation["object_version"].(string); ok && v != "" {
+					codeContent.S3ContentLocation.ObjectVersion = aws.String(v)
+				}
+			}
+
+			if v, ok := mContent["text_content"].(string); ok && v != "" {
+				codeContent.TextContent = aws.String(v)
+			}
+
+			applicationConfiguration.ApplicationCodeConfiguration.CodeContent = codeContent
+		}
+	}
+
+	if v, ok := m["application_snapshot_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(v[0].(map[string]interface{})["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if v, ok := m["environment_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		applicationConfiguration.EnvironmentProperties = &kinesisanalyticsv2.EnvironmentProperties{
+			PropertyGroups: expandKinesisAnalyticsV2PropertyGroups(v[0].(map[string]interface{})["property_group"].(*schema.Set).List()),
+		}
+	}
+
+	if v, ok := m["flink_application_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mFlink := v[0].(map[string]interface{})
+		flinkConfiguration := &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+		if v, ok := mFlink["checkpoint_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mCheckpoint := v[0].(map[string]interface{})
+			configurationType := mCheckpoint["configuration_type"].(string)
+
+			flinkConfiguration.CheckpointConfiguration = &kinesisanalyticsv2.CheckpointConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			// The remaining settings are only accepted for custom configurations.
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				flinkConfiguration.CheckpointConfiguration.CheckpointingEnabled = aws.Bool(mCheckpoint["checkpointing_enabled"].(bool))
+
+				if v, ok := mCheckpoint["checkpoint_interval"].(int); ok && v > 0 {
+					flinkConfiguration.CheckpointConfiguration.CheckpointInterval = aws.Int64(int64(v))
+				}
+
+				if v, ok := mCheckpoint["min_pause_between_checkpoints"].(int); ok && v > 0 {
+					flinkConfiguration.CheckpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(v))
+				}
+			}
+		}
+
+		if v, ok := mFlink["monitoring_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mMonitoring := v[0].(map[string]interface{})
+			configurationType := mMonitoring["configuration_type"].(string)
+
+			flinkConfiguration.MonitoringConfiguration = &kinesisanalyticsv2.MonitoringConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				if v, ok := mMonitoring["log_level"].(string); ok && v != "" {
+					flinkConfiguration.MonitoringConfiguration.LogLevel = aws.String(v)
+				}
+
+				if v, ok := mMonitoring["metrics_level"].(string); ok && v != "" {
+					flinkConfiguration.MonitoringConfiguration.MetricsLevel = aws.String(v)
+				}
+			}
+		}
+
+		if v, ok := mFlink["parallelism_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			mParallelism := v[0].(map[string]interface{})
+			configurationType := mParallelism["configuration_type"].(string)
+
+			flinkConfiguration.ParallelismConfiguration = &kinesisanalyticsv2.ParallelismConfiguration{
+				ConfigurationType: aws.String(configurationType),
+			}
+
+			if configurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+				flinkConfiguration.ParallelismConfiguration.AutoScalingEnabled = aws.Bool(mParallelism["auto_scaling_enabled"].(bool))
+
+				if v, ok := mParallelism["parallelism"].(int); ok && v > 0 {
+					flinkConfiguration.ParallelismConfiguration.Parallelism = aws.Int64(int64(v))
+				}
+
+				if v, ok := mParallelism["parallelism_per_kpu"].(int); ok && v > 0 {
+					flinkConfiguration.ParallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(v))
+				}
+			}
+		}
+
+		applicationConfiguration.FlinkApplicationConfiguration = flinkConfiguration
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationConfigurationUpdate(d *schema.ResourceData) *kinesisanalyticsv2.ApplicationConfigurationUpdate {
+	applicationConfiguration := expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{}))
+	if applicationConfiguration == nil {
+		return nil
+	}
+
+	update := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+
+	if d.HasChange("application_configuration.0.application_code_configuration") {
+		if codeConfiguration := applicationConfiguration.ApplicationCodeConfiguration; codeConfiguration != nil {
+			update.ApplicationCodeConfigurationUpdate = &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{
+				CodeContentTypeUpdate: codeConfiguration.CodeContentType,
+			}
+
+			if codeContent := codeConfiguration.CodeContent; codeContent != nil {
+				update.ApplicationCodeConfigurationUpdate.CodeContentUpdate = &kinesisanalyticsv2.CodeContentUpdate{
+					TextContentUpdate: codeContent.TextContent,
+				}
+
+				if location := codeContent.S3ContentLocation; location != nil {
+					update.ApplicationCodeConfigurationUpdate.CodeContentUpdate.S3ContentLocationUpdate = &kinesisanalyticsv2.S3ContentLocationUpdate{
+						BucketARNUpdate:     location.BucketARN,
+						FileKeyUpdate:       location.FileKey,
+						ObjectVersionUpdate: location.ObjectVersion,
+					}
+				}
+			}
+		}
+	}
+
+	if d.HasChange("application_configuration.0.application_snapshot_configuration") {
+		if snapshotConfiguration := applicationConfiguration.ApplicationSnapshotConfiguration; snapshotConfiguration != nil {
+			update.ApplicationSnapshotConfigurationUpdate = &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+				SnapshotsEnabledUpdate: snapshotConfiguration.SnapshotsEnabled,
+			}
+		}
+	}
+
+	if d.HasChange("application_configuration.0.environment_properties") {
+		// Removing all property groups is done by sending an empty list.
+		update.EnvironmentPropertyUpdates = &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+			PropertyGroups: []*kinesisanalyticsv2.PropertyGroup{},
+		}
+
+		if environmentProperties := applicationConfiguration.EnvironmentProperties; environmentProperties != nil {
+			update.EnvironmentPropertyUpdates.PropertyGroups = environmentProperties.PropertyGroups
+		}
+	}
+
+	if d.HasChange("application_configuration.0.flink_application_configuration") {
+		if flinkConfiguration := applicationConfiguration.FlinkApplicationConfiguration; flinkConfiguration != nil {
+			update.FlinkApplicationConfigurationUpdate = &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+			if c := flinkConfiguration.CheckpointConfiguration; c != nil {
+				update.FlinkApplicationConfigurationUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+					CheckpointIntervalUpdate:         c.CheckpointInterval,
+					CheckpointingEnabledUpdate:       c.CheckpointingEnabled,
+					ConfigurationTypeUpdate:          c.ConfigurationType,
+					MinPauseBetweenCheckpointsUpdate: c.MinPauseBetweenCheckpoints,
+				}
+			}
+
+			if c := flinkConfiguration.MonitoringConfiguration; c != nil {
+				update.FlinkApplicationConfigurationUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+					ConfigurationTypeUpdate: c.ConfigurationType,
+					LogLevelUpdate:          c.LogLevel,
+					MetricsLevelUpdate:      c.MetricsLevel,
+				}
+			}
+
+			if c := flinkConfiguration.ParallelismConfiguration; c != nil {
+				update.FlinkApplicationConfigurationUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+					AutoScalingEnabledUpdate: c.AutoScalingEnabled,
+					ConfigurationTypeUpdate:  c.ConfigurationType,
+					ParallelismPerKPUUpdate:  c.ParallelismPerKPU,
+					ParallelismUpdate:        c.Parallelism,
+				}
+			}
+		}
+	}
+
+	return update
+}
+
+func expandKinesisAnalyticsV2RunConfiguration(l []interface{}) *kinesisanalyticsv2.RunConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	v, ok := l[0].(map[string]interface{})["run_configuration"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	v, ok = v[0].(map[string]interface{})["application_restore_configuration"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	m := v[0].(map[string]interface{})
+	restoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{}
+
+	if v, ok := m["application_restore_type"].(string); ok && v != "" {
+		restoreConfiguration.ApplicationRestoreType = aws.String(v)
+	}
+
+	if v, ok := m["snapshot_name"].(string); ok && v != "" {
+		restoreConfiguration.SnapshotName = aws.String(v)
+	}
+
+	if restoreConfiguration.ApplicationRestoreType == nil {
+		return nil
+	}
+
+	return &kinesisanalyticsv2.RunConfiguration{
+		ApplicationRestoreConfiguration: restoreConfiguration,
+	}
+}
+
+func expandKinesisAnalyticsV2PropertyGroups(l []interface{}) []*kinesisanalyticsv2.PropertyGroup {
+	propertyGroups := make([]*kinesisanalyticsv2.PropertyGroup, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		propertyGroups = append(propertyGroups, &kinesisanalyticsv2.PropertyGroup{
+			PropertyGroupId: aws.String(m["property_group_id"].(string)),
+			PropertyMap:     stringMapToPointers(m["property_map"].(map[string]interface{})),
+		})
+	}
+
+	return propertyGroups
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(l []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{
+		{
+			LogStreamARN: aws.String(m["log_stream_arn"].(string)),
+		},
+	}
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(description *kinesisanalyticsv2.ApplicationConfigurationDescription) []interface{} {
+	if description == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if codeConfiguration := description.ApplicationCodeConfigurationDescription; codeConfiguration != nil {
+		mCode := map[string]interface{}{
+			"code_content_type": aws.StringValue(codeConfiguration.CodeContentType),
+		}
+
+		if codeContent := codeConfiguration.CodeContentDescription; codeContent != nil {
+			mContent := map[string]interface{}{
+				"text_content": aws.StringValue(codeContent.TextContent),
+			}
+
+			if location := codeContent.S3ApplicationCodeLocationDescription; location != nil {
+				mContent["s3_content_location"] = []interface{}{
+					map[string]interface{}{
+						"bucket_arn":     aws.StringValue(location.BucketARN),
+						"file_key":       aws.StringValue(location.FileKey),
+						"object_version": aws.StringValue(location.ObjectVersion),
+					},
+				}
+			}
+
+			mCode["code_content"] = []interface{}{mContent}
+		}
+
+		m["application_code_configuration"] = []interface{}{mCode}
+	}
+
+	if snapshotConfiguration := description.ApplicationSnapshotConfigurationDescription; snapshotConfiguration != nil {
+		m["application_snapshot_configuration"] = []interface{}{
+			map[string]interface{}{
+				"snapshots_enabled": aws.BoolValue(snapshotConfiguration.SnapshotsEnabled),
+			},
+		}
+	}
+
+	if environmentProperties := description.EnvironmentPropertyDescriptions; environmentProperties != nil && len(environmentProperties.PropertyGroupDescriptions) > 0 {
+		propertyGroups := make([]interface{}, 0, len(environmentProperties.PropertyGroupDescriptions))
+
+		for _, propertyGroup := range environmentProperties.PropertyGroupDescriptions {
+			if propertyGroup == nil {
+				continue
+			}
+
+			propertyGroups = append(propertyGroups, map[string]interface{}{
+				"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+				"property_map":      aws.StringValueMap(propertyGroup.PropertyMap),
+			})
+		}
+
+		m["environment_properties"] = []interface{}{
+			map[string]interface{}{
+				"property_group": propertyGroups,
+			},
+		}
+	}
+
+	if flinkConfiguration := description.FlinkApplicationConfigurationDescription; flinkConfiguration != nil {
+		mFlink := map[string]interface{}{}
+
+		if c := flinkConfiguration.CheckpointConfigurationDescription; c != nil {
+			mFlink["checkpoint_configuration"] = []interface{}{
+				map[string]interface{}{
+					"checkpoint_interval":           int(aws.Int64Value(c.CheckpointInterval)),
+					"checkpointing_enabled":         aws.BoolValue(c.CheckpointingEnabled),
+					"configuration_type":            aws.StringValue(c.ConfigurationType),
+					"min_pause_between_checkpoints": int(aws.Int64Value(c.MinPauseBetweenCheckpoints)),
+				},
+			}
+		}
+
+		if c := flinkConfiguration.MonitoringConfigurationDescription; c != nil {
+			mFlink["monitoring_configuration"] = []interface{}{
+				map[string]interface{}{
+					"configuration_type": aws.StringValue(c.ConfigurationType),
+					"log_level":          aws.StringValue(c.LogLevel),
+					"metrics_level":      aws.StringValue(c.MetricsLevel),
+				},
+			}
+		}
+
+		if c := flinkConfiguration.ParallelismConfigurationDescription; c != nil {
+			mFlink["parallelism_configuration"] = []interface{}{
+				map[string]interface{}{
+					"auto_scaling_enabled": aws.BoolValue(c.AutoScalingEnabled),
+					"configuration_type":   aws.StringValue(c.ConfigurationType),
+					"parallelism":          int(aws.Int64Value(c.Parallelism)),
+					"parallelism_per_kpu":  int(aws.Int64Value(c.ParallelismPerKPU)),
+				},
+			}
+		}
+
+		m["flink_application_configuration"] = []interface{}{mFlink}
+	}
+
+	if runConfiguration := description.RunConfigurationDescription; runConfiguration != nil {
+		mRun := map[string]interface{}{}
+
+		if c := runConfiguration.ApplicationRestoreConfigurationDescription; c != nil {
+			mRun["application_restore_configuration"] = []interface{}{
+				map[string]interface{}{
+					"application_restore_type": aws.StringValue(c.ApplicationRestoreType),
+					"snapshot_name":            aws.StringValue(c.SnapshotName),
+				},
+			}
+		}
+
+		m["run_configuration"] = []interface{}{mRun}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(descriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	l := make([]interface{}, 0, len(descriptions))
+
+	for _, description := range descriptions {
+		if description == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"cloudwatch_logging_option_id": aws.StringValue(description.CloudWatchLoggingOptionId),
+			"log_stream_arn":               aws.StringValue(description.LogStreamARN),
+		})
+	}
+
+	return l
+}
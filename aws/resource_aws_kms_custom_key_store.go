@@ -0,0 +1,343 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsCustomKeyStoreCreate,
+		Read:   resourceAwsKmsCustomKeyStoreRead,
+		Update: resourceAwsKmsCustomKeyStoreUpdate,
+		Delete: resourceAwsKmsCustomKeyStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connected": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"connection_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"key_store_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 32),
+			},
+			"trust_anchor_certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsKmsCustomKeyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.CreateCustomKeyStoreInput{
+		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+		CustomKeyStoreName:     aws.String(d.Get("custom_key_store_name").(string)),
+		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
+		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating KMS Custom Key Store: %s", input)
+	output, err := conn.CreateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS Custom Key Store: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+
+	if d.Get("connected").(bool) {
+		if err := connectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKmsCustomKeyStoreRead(d, meta)
+}
+
+func resourceAwsKmsCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	customKeyStore, err := describeKmsCustomKeyStore(conn, d.Id())
+
+	if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	if customKeyStore == nil {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cloud_hsm_cluster_id", customKeyStore.CloudHsmClusterId)
+	d.Set("connected", aws.StringValue(customKeyStore.ConnectionState) == kms.ConnectionStateTypeConnected)
+	d.Set("connection_error_code", customKeyStore.ConnectionErrorCode)
+	d.Set("connection_state", customKeyStore.ConnectionState)
+	d.Set("custom_key_store_name", customKeyStore.CustomKeyStoreName)
+	d.Set("trust_anchor_certificate", customKeyStore.TrustAnchorCertificate)
+
+	return nil
+}
+
+func resourceAwsKmsCustomKeyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("cloud_hsm_cluster_id") || d.HasChange("custom_key_store_name") || d.HasChange("key_store_password") {
+		// The cluster and password can only be changed while the key store is disconnected.
+		if d.HasChange("cloud_hsm_cluster_id") || d.HasChange("key_store_password") {
+			if err := disconnectKmsCustomKeyStore(conn, d.Id(), timeout); err != nil {
+				return err
+			}
+		}
+
+		input := &kms.UpdateCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("cloud_hsm_cluster_id") {
+			input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+		}
+
+		if d.HasChange("custom_key_store_name") {
+			input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		}
+
+		if d.HasChange("key_store_password") {
+			input.KeyStorePassword = aws.String(d.Get("key_store_password").(string))
+		}
+
+		log.Printf("[DEBUG] Updating KMS Custom Key Store: %s", input)
+		if _, err := conn.UpdateCustomKeyStore(input); err != nil {
+			return fmt.Errorf("error updating KMS Custom Key Store (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("connected").(bool) {
+		if err := connectKmsCustomKeyStore(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+	} else {
+		if err := disconnectKmsCustomKeyStore(conn, d.Id(), timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKmsCustomKeyStoreRead(d, meta)
+}
+
+func resourceAwsKmsCustomKeyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	// A custom key store must be disconnected before it can be deleted.
+	err := disconnectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	input := &kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Custom Key Store: %s", input)
+	_, err = conn.DeleteCustomKeyStore(input)
+
+	if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeKmsCustomKeyStore(conn *kms.KMS, id string) (*kms.CustomKeyStoresListEntry, error) {
+	output, err := conn.DescribeCustomKeyStores(&kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customKeyStore := range output.CustomKeyStores {
+		if aws.StringValue(customKeyStore.CustomKeyStoreId) == id {
+			return customKeyStore, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// connectKmsCustomKeyStore connects the custom key store to its CloudHSM cluster and waits for it to
+// be CONNECTED. A store in the FAILED state is disconnected first, as KMS requires before retrying.
+func connectKmsCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	customKeyStore, err := describeKmsCustomKeyStore(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %s", id, err)
+	}
+
+	if customKeyStore == nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): not found", id)
+	}
+
+	switch aws.StringValue(customKeyStore.ConnectionState) {
+	case kms.ConnectionStateTypeConnected:
+		return nil
+	case kms.ConnectionStateTypeFailed:
+		if err := disconnectKmsCustomKeyStore(conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	if aws.StringValue(customKeyStore.ConnectionState) != kms.ConnectionStateTypeConnecting {
+		log.Printf("[DEBUG] Connecting KMS Custom Key Store: %s", id)
+		_, err := conn.ConnectCustomKeyStore(&kms.ConnectCustomKeyStoreInput{
+			CustomKeyStoreId: aws.String(id),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error connecting KMS Custom Key Store (%s): %s", id, err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			kms.ConnectionStateTypeConnecting,
+			kms.ConnectionStateTypeDisconnected,
+		},
+		Target:     []string{kms.ConnectionStateTypeConnected},
+		Refresh:    kmsCustomKeyStoreConnectionStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok && aws.StringValue(v.ConnectionState) == kms.ConnectionStateTypeFailed {
+		return fmt.Errorf("error connecting KMS Custom Key Store (%s): connection failed: %s", id, aws.StringValue(v.ConnectionErrorCode))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to connect: %s", id, err)
+	}
+
+	return nil
+}
+
+func disconnectKmsCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	customKeyStore, err := describeKmsCustomKeyStore(conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if customKeyStore == nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): not found", id)
+	}
+
+	if aws.StringValue(customKeyStore.ConnectionState) == kms.ConnectionStateTypeDisconnected {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Disconnecting KMS Custom Key Store: %s", id)
+	_, err = conn.DisconnectCustomKeyStore(&kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disconnecting KMS Custom Key Store (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			kms.ConnectionStateTypeConnected,
+			kms.ConnectionStateTypeConnecting,
+			kms.ConnectionStateTypeDisconnecting,
+			kms.ConnectionStateTypeFailed,
+		},
+		Target:     []string{kms.ConnectionStateTypeDisconnected},
+		Refresh:    kmsCustomKeyStoreConnectionStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to disconnect: %s", id, err)
+	}
+
+	return nil
+}
+
+func kmsCustomKeyStoreConnectionStateRefreshFunc(conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		customKeyStore, err := describeKmsCustomKeyStore(conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if customKeyStore == nil {
+			return nil, "", nil
+		}
+
+		return customKeyStore, aws.StringValue(customKeyStore.ConnectionState), nil
+	}
+}
@@ -0,0 +1,149 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKmsCustomKeyStore_basic(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	clusterId, password, trustAnchorCertificate := testAccAWSKmsCustomKeyStoreEnvironment(t)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsCustomKeyStoreConfig(rName, clusterId, password, trustAnchorCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterId),
+					resource.TestCheckResourceAttr(resourceName, "connected", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeConnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+			{
+				Config: testAccAWSKmsCustomKeyStoreConfig(rName+"-updated", clusterId, password, trustAnchorCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "connected", "false"),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", kms.ConnectionStateTypeDisconnected),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAWSKmsCustomKeyStoreEnvironment returns the initialized CloudHSM cluster, kmsuser password and
+// trust anchor certificate required to test custom key stores, skipping the test when they are not configured.
+func testAccAWSKmsCustomKeyStoreEnvironment(t *testing.T) (string, string, string) {
+	clusterId := os.Getenv("KMS_CUSTOM_KEY_STORE_CLUSTER_ID")
+	if clusterId == "" {
+		t.Skip("Environment variable KMS_CUSTOM_KEY_STORE_CLUSTER_ID is not set")
+	}
+
+	password := os.Getenv("KMS_CUSTOM_KEY_STORE_PASSWORD")
+	if password == "" {
+		t.Skip("Environment variable KMS_CUSTOM_KEY_STORE_PASSWORD is not set")
+	}
+
+	trustAnchorCertificatePath := os.Getenv("KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE_PATH")
+	if trustAnchorCertificatePath == "" {
+		t.Skip("Environment variable KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE_PATH is not set")
+	}
+
+	trustAnchorCertificate, err := ioutil.ReadFile(trustAnchorCertificatePath)
+	if err != nil {
+		t.Fatalf("error reading trust anchor certificate (%s): %s", trustAnchorCertificatePath, err)
+	}
+
+	return clusterId, password, string(trustAnchorCertificate)
+}
+
+func testAccCheckAWSKmsCustomKeyStoreExists(resourceName string, customKeyStore *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Custom Key Store ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+		output, err := describeKmsCustomKeyStore(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("KMS Custom Key Store (%s) not found", rs.Primary.ID)
+		}
+
+		*customKeyStore = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		output, err := describeKmsCustomKeyStore(conn, rs.Primary.ID)
+
+		if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("KMS Custom Key Store (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSKmsCustomKeyStoreConfig(rName, clusterId, password, trustAnchorCertificate string, connected bool) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id  = %[2]q
+  connected             = %[5]t
+  custom_key_store_name = %[1]q
+  key_store_password    = %[3]q
+
+  trust_anchor_certificate = <<EOF
+%[4]s
+EOF
+}
+`, rName, clusterId, password, trustAnchorCertificate, connected)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsKmsExternalKey() *schema.Resource {
@@ -29,6 +30,8 @@ func resourceAwsKmsExternalKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -78,7 +81,8 @@ func resourceAwsKmsExternalKey() *schema.Resource {
 					validation.ValidateJsonString,
 				),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 			"valid_to": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -104,8 +108,8 @@ func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) e
 		input.Policy = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().KmsTags()
 	}
 
 	var output *kms.CreateKeyOutput
@@ -157,6 +161,7 @@ func resourceAwsKmsExternalKeyCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -249,7 +254,7 @@ func resourceAwsKmsExternalKeyRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("policy", policy)
 
-	if err := d.Set("tags", tagsToMapKMS(listResourceTagsOutput.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.KmsKeyValueTags(listResourceTagsOutput.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -314,8 +319,12 @@ func resourceAwsKmsExternalKeyUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if err := setTagsKMS(conn, d, d.Id()); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.KmsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsKmsExternalKeyRead(d, meta)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsKmsKey() *schema.Resource {
@@ -26,6 +27,8 @@ func resourceAwsKmsKey() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -67,12 +70,20 @@ func resourceAwsKmsKey() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"custom_key_store_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 64),
+				ConflictsWith: []string{"enable_key_rotation"},
+			},
 			"deletion_window_in_days": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -91,8 +102,12 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
-		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
+	if v, exists := d.GetOk("custom_key_store_id"); exists {
+		req.CustomKeyStoreId = aws.String(v.(string))
+		req.Origin = aws.String(kms.OriginTypeAwsCloudhsm)
+	}
+	if v, exists := d.GetOk("tags_all"); exists {
+		req.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().KmsTags()
 	}
 
 	var resp *kms.CreateKeyOutput
@@ -123,6 +138,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -157,6 +173,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", metadata.Description)
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
+	d.Set("custom_key_store_id", metadata.CustomKeyStoreId)
 
 	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
@@ -175,16 +192,21 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("policy", policy)
 
-	out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
-		return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
-			KeyId: metadata.KeyId,
+	// Automatic key rotation is not supported for keys in custom key stores
+	if metadata.CustomKeyStoreId == nil {
+		out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+			return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
+				KeyId: metadata.KeyId,
+			})
 		})
-	})
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
+		krs, _ := out.(*kms.GetKeyRotationStatusOutput)
+		d.Set("enable_key_rotation", krs.KeyRotationEnabled)
+	} else {
+		d.Set("enable_key_rotation", false)
 	}
-	krs, _ := out.(*kms.GetKeyRotationStatusOutput)
-	d.Set("enable_key_rotation", krs.KeyRotationEnabled)
 
 	tOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
 		return conn.ListResourceTags(&kms.ListResourceTagsInput{
@@ -195,7 +217,9 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
 	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.KmsKeyValueTags(tagList.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	return nil
 }
@@ -236,8 +260,12 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if err := setTagsKMS(conn, d, d.Id()); err != nil {
-		return err
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.KmsUpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
 	}
 
 	return resourceAwsKmsKeyRead(d, meta)
@@ -220,6 +220,35 @@ func TestAccAWSKmsKey_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSKmsKey_customKeyStore(t *testing.T) {
+	var key kms.KeyMetadata
+	clusterId, password, trustAnchorCertificate := testAccAWSKmsCustomKeyStoreEnvironment(t)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsKey_customKeyStore(rName, clusterId, password, trustAnchorCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttrPair(resourceName, "custom_key_store_id", "aws_kms_custom_key_store.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_window_in_days"},
+			},
+		},
+	})
+}
+
 func testAccCheckAWSKmsKeyHasPolicy(name string, expectedPolicyText string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -458,3 +487,13 @@ resource "aws_kms_key" "foo" {
 }
 `, rName, rName)
 }
+
+func testAccAWSKmsKey_customKeyStore(rName, clusterId, password, trustAnchorCertificate string) string {
+	return testAccAWSKmsCustomKeyStoreConfig(rName, clusterId, password, trustAnchorCertificate, true) + fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  custom_key_store_id     = "${aws_kms_custom_key_store.test.id}"
+  deletion_window_in_days = 7
+  description             = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,239 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLakeFormationDataLakeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationDataLakeSettingsPut,
+		Read:   resourceAwsLakeFormationDataLakeSettingsRead,
+		Update: resourceAwsLakeFormationDataLakeSettingsPut,
+		Delete: resourceAwsLakeFormationDataLakeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"create_database_default_permissions": lakeFormationPrincipalPermissionsSchema(),
+			"create_table_default_permissions":    lakeFormationPrincipalPermissionsSchema(),
+		},
+	}
+}
+
+func lakeFormationPrincipalPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"permissions": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+					},
+				},
+				"principal": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationDataLakeSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+	catalogID := meta.(*AWSClient).accountid
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	}
+
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(catalogID),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: expandLakeFormationPrincipalPermissions(d.Get("create_database_default_permissions").([]interface{})),
+			CreateTableDefaultPermissions:    expandLakeFormationPrincipalPermissions(d.Get("create_table_default_permissions").([]interface{})),
+			DataLakeAdmins:                   expandLakeFormationDataLakePrincipals(d.Get("admins").(*schema.Set)),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting Lake Formation Data Lake Settings: %s", input)
+	if _, err := conn.PutDataLakeSettings(input); err != nil {
+		return fmt.Errorf("error putting Lake Formation Data Lake Settings (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsLakeFormationDataLakeSettingsRead(d, meta)
+}
+
+func resourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	output, err := conn.GetDataLakeSettings(&lakeformation.GetDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	settings := output.DataLakeSettings
+
+	if settings == nil {
+		log.Printf("[WARN] Lake Formation Data Lake Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("admins", flattenLakeFormationDataLakePrincipals(settings.DataLakeAdmins)); err != nil {
+		return fmt.Errorf("error setting admins: %s", err)
+	}
+
+	if err := d.Set("create_database_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateDatabaseDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_database_default_permissions: %s", err)
+	}
+
+	if err := d.Set("create_table_default_permissions", flattenLakeFormationPrincipalPermissions(settings.CreateTableDefaultPermissions)); err != nil {
+		return fmt.Errorf("error setting create_table_default_permissions: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	// There is no API to remove the settings, so reset them to empty.
+	input := &lakeformation.PutDataLakeSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataLakeSettings: &lakeformation.DataLakeSettings{
+			CreateDatabaseDefaultPermissions: []*lakeformation.PrincipalPermissions{},
+			CreateTableDefaultPermissions:    []*lakeformation.PrincipalPermissions{},
+			DataLakeAdmins:                   []*lakeformation.DataLakePrincipal{},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Lake Formation Data Lake Settings: %s", input)
+	if _, err := conn.PutDataLakeSettings(input); err != nil {
+		return fmt.Errorf("error resetting Lake Formation Data Lake Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func lakeFormationPermissionValues() []string {
+	return []string{
+		lakeformation.PermissionAll,
+		lakeformation.PermissionAlter,
+		lakeformation.PermissionCreateDatabase,
+		lakeformation.PermissionCreateTable,
+		lakeformation.PermissionDataLocationAccess,
+		lakeformation.PermissionDelete,
+		lakeformation.PermissionDrop,
+		lakeformation.PermissionInsert,
+		lakeformation.PermissionSelect,
+	}
+}
+
+func expandLakeFormationDataLakePrincipals(s *schema.Set) []*lakeformation.DataLakePrincipal {
+	principals := make([]*lakeformation.DataLakePrincipal, 0, s.Len())
+
+	for _, v := range s.List() {
+		principals = append(principals, &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(v.(string)),
+		})
+	}
+
+	return principals
+}
+
+func flattenLakeFormationDataLakePrincipals(principals []*lakeformation.DataLakePrincipal) []interface{} {
+	l := make([]interface{}, 0, len(principals))
+
+	for _, principal := range principals {
+		if principal == nil {
+			continue
+		}
+
+		l = append(l, aws.StringValue(principal.DataLakePrincipalIdentifier))
+	}
+
+	return l
+}
+
+func expandLakeFormationPrincipalPermissions(l []interface{}) []*lakeformation.PrincipalPermissions {
+	permissions := make([]*lakeformation.PrincipalPermissions, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		permissions = append(permissions, &lakeformation.PrincipalPermissions{
+			Permissions: expandStringSet(m["permissions"].(*schema.Set)),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(m["principal"].(string)),
+			},
+		})
+	}
+
+	return permissions
+}
+
+func flattenLakeFormationPrincipalPermissions(permissions []*lakeformation.PrincipalPermissions) []interface{} {
+	l := make([]interface{}, 0, len(permissions))
+
+	for _, permission := range permissions {
+		if permission == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"permissions": flattenStringSet(permission.Permissions),
+		}
+
+		if permission.Principal != nil {
+			m["principal"] = aws.StringValue(permission.Principal.DataLakePrincipalIdentifier)
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
@@ -0,0 +1,333 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLakeFormationPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLakeFormationPermissionsCreate,
+		Read:   resourceAwsLakeFormationPermissionsRead,
+		Delete: resourceAwsLakeFormationPermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"catalog_resource": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"data_location", "database", "table"},
+			},
+			"data_location": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "database", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"database": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "data_location", "table"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+				},
+			},
+			"permissions_with_grant_option": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(lakeFormationPermissionValues(), false),
+				},
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"catalog_resource", "data_location", "database"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	lfResource, err := expandLakeFormationResource(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Granting Lake Formation Permissions: %s", input)
+	// Retry for IAM eventual consistency
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		if isAWSErr(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error granting Lake Formation Permissions: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	return resourceAwsLakeFormationPermissionsRead(d, meta)
+}
+
+func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+	principal := d.Get("principal").(string)
+
+	lfResource, err := expandLakeFormationResource(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	var permissions, permissionsWithGrantOption []*string
+
+	err = conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != principal {
+				continue
+			}
+
+			permissions = append(permissions, permission.Permissions...)
+			permissionsWithGrantOption = append(permissionsWithGrantOption, permission.PermissionsWithGrantOption...)
+		}
+		return !lastPage
+	})
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	// Other permissions for the same principal and resource may be granted
+	// outside of this resource, so only the configured permissions are read.
+	permissions = filterLakeFormationPermissions(permissions, d.Get("permissions").(*schema.Set))
+	permissionsWithGrantOption = filterLakeFormationPermissions(permissionsWithGrantOption, d.Get("permissions_with_grant_option").(*schema.Set))
+
+	if len(permissions) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("permissions", flattenStringSet(permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %s", err)
+	}
+
+	if err := d.Set("permissions_with_grant_option", flattenStringSet(permissionsWithGrantOption)); err != nil {
+		return fmt.Errorf("error setting permissions_with_grant_option: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lakeformationconn
+
+	lfResource, err := expandLakeFormationResource(d)
+
+	if err != nil {
+		return err
+	}
+
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions: expandStringSet(d.Get("permissions").(*schema.Set)),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(d.Get("principal").(string)),
+		},
+		Resource: lfResource,
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("permissions_with_grant_option"); ok {
+		input.PermissionsWithGrantOption = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Revoking Lake Formation Permissions: %s", input)
+	_, err = conn.RevokePermissions(input)
+
+	if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error revoking Lake Formation Permissions (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// filterLakeFormationPermissions returns the permissions that are also in
+// the configured set.
+func filterLakeFormationPermissions(permissions []*string, configured *schema.Set) []*string {
+	var filtered []*string
+
+	for _, permission := range permissions {
+		if configured.Contains(aws.StringValue(permission)) {
+			filtered = append(filtered, permission)
+		}
+	}
+
+	return filtered
+}
+
+func expandLakeFormationResource(d *schema.ResourceData) (*lakeformation.Resource, error) {
+	if v, ok := d.GetOk("catalog_resource"); ok && v.(bool) {
+		return &lakeformation.Resource{
+			Catalog: &lakeformation.CatalogResource{},
+		}, nil
+	}
+
+	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			DataLocation: &lakeformation.DataLocationResource{
+				ResourceArn: aws.String(m["arn"].(string)),
+			},
+		}, nil
+	}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			Database: &lakeformation.DatabaseResource{
+				Name: aws.String(m["name"].(string)),
+			},
+		}, nil
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		return &lakeformation.Resource{
+			Table: &lakeformation.TableResource{
+				DatabaseName: aws.String(m["database_name"].(string)),
+				Name:         aws.String(m["name"].(string)),
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("one of catalog_resource, data_location, database or table must be configured")
+}
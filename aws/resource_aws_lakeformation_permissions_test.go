@@ -0,0 +1,332 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSLakeFormationPermissions_database(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("lakeformation", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_dataLocation(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("lakeformation", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_dataLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "data_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_location.0.arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_multiple(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName1 := "aws_lakeformation_permissions.test1"
+	resourceName2 := "aws_lakeformation_permissions.test2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("lakeformation", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName1),
+					resource.TestCheckResourceAttr(resourceName1, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName1, "permissions_with_grant_option.#", "0"),
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "permissions_with_grant_option.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLakeFormationPermissions_table(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("lakeformation", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLakeFormationPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLakeFormationPermissionsConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLakeFormationPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.database_name", "aws_glue_catalog_table.test", "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLakeFormationPermissionsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		count, err := testAccAWSLakeFormationPermissionsCount(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions" {
+			continue
+		}
+
+		count, err := testAccAWSLakeFormationPermissionsCount(rs)
+
+		if isAWSErr(err, lakeformation.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return fmt.Errorf("Lake Formation Permissions (%s) still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLakeFormationPermissionsCount(rs *terraform.ResourceState) (int, error) {
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+	principal := rs.Primary.Attributes["principal"]
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	switch {
+	case rs.Primary.Attributes["catalog_resource"] == "true":
+		input.Resource.Catalog = &lakeformation.CatalogResource{}
+	case rs.Primary.Attributes["data_location.#"] == "1":
+		input.Resource.DataLocation = &lakeformation.DataLocationResource{
+			ResourceArn: aws.String(rs.Primary.Attributes["data_location.0.arn"]),
+		}
+	case rs.Primary.Attributes["database.#"] == "1":
+		input.Resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	case rs.Primary.Attributes["table.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table.0.name"]),
+		}
+	}
+
+	count := 0
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || permission.Principal == nil {
+				continue
+			}
+
+			if aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) == principal {
+				count += len(permission.Permissions)
+			}
+		}
+		return !lastPage
+	})
+
+	return count, err
+}
+
+func testAccAWSLakeFormationPermissionsConfig_Base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = ["${data.aws_caller_identity.current.arn}"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_database(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+  principal                     = "${aws_iam_role.test.arn}"
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_dataLocation(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_lakeformation_resource" "test" {
+  arn = "${aws_s3_bucket.test.arn}"
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["DATA_LOCATION_ACCESS"]
+  principal   = "${aws_iam_role.test.arn}"
+
+  data_location {
+    arn = "${aws_lakeformation_resource.test.arn}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_multiple(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_lakeformation_permissions" "test1" {
+  permissions = ["ALTER"]
+  principal   = "${aws_iam_role.test.arn}"
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+
+resource "aws_lakeformation_permissions" "test2" {
+  permissions = ["CREATE_TABLE", "DROP"]
+  principal   = "${aws_iam_role.test.arn}"
+
+  database {
+    name = "${aws_glue_catalog_database.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
+
+func testAccAWSLakeFormationPermissionsConfig_table(rName string) string {
+	return testAccAWSLakeFormationPermissionsConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = "${aws_glue_catalog_database.test.name}"
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["ALL"]
+  principal   = "${aws_iam_role.test.arn}"
+
+  table {
+    database_name = "${aws_glue_catalog_table.test.database_name}"
+    name          = "${aws_glue_catalog_table.test.name}"
+  }
+
+  depends_on = ["aws_lakeformation_data_lake_settings.test"]
+}
+`, rName)
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Lake Formation data lake settings are account-wide and permissions can only be
+// granted by a data lake administrator, so these tests must run serially.
+func TestAccAWSLakeFormation(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"DataLakeSettings": {
+			"basic": testAccAWSLakeFormationDataLakeSettings_basic,
+		},
+		"Permissions": {
+			"database":     testAccAWSLakeFormationPermissions_database,
+			"dataLocation": testAccAWSLakeFormationPermissions_dataLocation,
+			"multiple":     testAccAWSLakeFormationPermissions_multiple,
+			"table":        testAccAWSLakeFormationPermissions_table,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsLaunchTemplate() *schema.Resource {
@@ -481,7 +482,8 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 								"volume",
 							}, false),
 						},
-						"tags": tagsSchema(),
+						"tags":     tagsSchema(),
+						"tags_all": tagsSchemaTrulyComputed(),
 					},
 				},
 			},
@@ -491,7 +493,8 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -506,6 +509,7 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			setTagsDiff,
 		),
 	}
 }
@@ -593,7 +597,9 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("name", lt.LaunchTemplateName)
 	d.Set("latest_version", lt.LatestVersionNumber)
 	d.Set("default_version", lt.DefaultVersionNumber)
-	d.Set("tags", tagsToMap(lt.Tags))
+	if err := setTagsAll(d, meta, keyvaluetags.Ec2KeyValueTags(lt.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
 
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
@@ -715,10 +721,15 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 
 	d.Partial(true)
 
-	if err := setTags(conn, d); err != nil {
-		return err
-	} else {
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
+			return err
+		}
+
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -993,7 +1004,7 @@ func getTagSpecifications(t []*ec2.LaunchTemplateTagSpecification) []interface{}
 	for _, v := range t {
 		s = append(s, map[string]interface{}{
 			"resource_type": aws.StringValue(v.ResourceType),
-			"tags":          tagsToMap(v.Tags),
+			"tags":          keyvaluetags.Ec2KeyValueTags(v.Tags).IgnoreAws().Map(),
 		})
 	}
 	return s
@@ -1172,7 +1183,7 @@ func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplate
 				continue
 			}
 			tsData := ts.(map[string]interface{})
-			tags := tagsFromMap(tsData["tags"].(map[string]interface{}))
+			tags := keyvaluetags.New(tsData["tags"].(map[string]interface{})).IgnoreAws().Ec2Tags()
 			tagSpecification := &ec2.LaunchTemplateTagSpecificationRequest{
 				ResourceType: aws.String(tsData["resource_type"].(string)),
 				Tags:         tags,
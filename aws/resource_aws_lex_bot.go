@@ -0,0 +1,479 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotCreate,
+		Read:   resourceAwsLexBotRead,
+		Update: resourceAwsLexBotUpdate,
+		Delete: resourceAwsLexBotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLexVersionCustomizeDiff(
+			"abort_statement",
+			"child_directed",
+			"clarification_prompt",
+			"description",
+			"idle_session_ttl_in_seconds",
+			"intent",
+			"voice_id",
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abort_statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource(),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"clarification_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource(),
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName,
+						},
+						"intent_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexVersion,
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelbuildingservice.LocaleEnUs,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.LocaleDeDe,
+					lexmodelbuildingservice.LocaleEnGb,
+					lexmodelbuildingservice.LocaleEnUs,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 50),
+					validateLexName,
+				),
+			},
+			"process_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.ProcessBehaviorSave,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ProcessBehaviorBuild,
+					lexmodelbuildingservice.ProcessBehaviorSave,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexBotInput(d)
+	input.Name = aws.String(name)
+
+	log.Printf("[DEBUG] Creating Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutBot(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating Lex Bot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(d.Id()),
+		VersionOrAlias: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	botARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("bot:%s", d.Id()),
+	}.String()
+	d.Set("arn", botARN)
+
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("voice_id", output.VoiceId)
+
+	if err := d.Set("abort_statement", flattenLexStatement(output.AbortStatement)); err != nil {
+		return fmt.Errorf("error setting abort_statement: %s", err)
+	}
+
+	if err := d.Set("clarification_prompt", flattenLexPrompt(output.ClarificationPrompt)); err != nil {
+		return fmt.Errorf("error setting clarification_prompt: %s", err)
+	}
+
+	if err := d.Set("intent", flattenLexIntents(output.Intents)); err != nil {
+		return fmt.Errorf("error setting intent: %s", err)
+	}
+
+	version, err := getLatestLexBotVersion(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexBotInput(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+	input.Name = aws.String(d.Id())
+
+	log.Printf("[DEBUG] Updating Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutBot(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error updating Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotBuild(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteBotInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Bot: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(input)
+
+		// Aliases that were just deleted can still be reported as referencing the bot.
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteBot(input)
+	}
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func lexBotStatusRefreshFunc(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.Status) == lexmodelbuildingservice.StatusFailed {
+			return output, lexmodelbuildingservice.StatusFailed, fmt.Errorf("%s", aws.StringValue(output.FailureReason))
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// waitForLexBotBuild waits for the $LATEST version of a bot to finish building.
+// Bots saved without being built settle in the NOT_BUILT status.
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelbuildingservice.StatusBuilding},
+		Target: []string{
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Refresh:    lexBotStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Lex Bot (%s) build", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForLexBotDeletion(conn *lexmodelbuildingservice.LexModelBuildingService, name string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			lexmodelbuildingservice.StatusBuilding,
+			lexmodelbuildingservice.StatusFailed,
+			lexmodelbuildingservice.StatusNotBuilt,
+			lexmodelbuildingservice.StatusReady,
+			lexmodelbuildingservice.StatusReadyBasicTesting,
+		},
+		Target:     []string{},
+		Refresh:    lexBotStatusRefreshFunc(conn, name),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Lex Bot (%s) deletion", name)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+// getLatestLexBotVersion returns the highest published version of a bot,
+// or $LATEST if no version has been published.
+func getLatestLexBotVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			versions = append(versions, aws.StringValue(bot.Version))
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return latestLexVersion(versions), nil
+}
+
+func expandLexBotInput(d *schema.ResourceData) *lexmodelbuildingservice.PutBotInput {
+	input := &lexmodelbuildingservice.PutBotInput{
+		AbortStatement:          expandLexStatement(d.Get("abort_statement").([]interface{})),
+		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
+		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt").([]interface{})),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		Description:             aws.String(d.Get("description").(string)),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		Intents:                 expandLexIntents(d.Get("intent").(*schema.Set).List()),
+		Locale:                  aws.String(d.Get("locale").(string)),
+		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
+	}
+
+	if v, ok := d.GetOk("voice_id"); ok {
+		input.VoiceId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func expandLexIntents(l []interface{}) []*lexmodelbuildingservice.Intent {
+	intents := make([]*lexmodelbuildingservice.Intent, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		intents = append(intents, &lexmodelbuildingservice.Intent{
+			IntentName:    aws.String(m["intent_name"].(string)),
+			IntentVersion: aws.String(m["intent_version"].(string)),
+		})
+	}
+
+	return intents
+}
+
+func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) []interface{} {
+	l := make([]interface{}, 0, len(intents))
+
+	for _, intent := range intents {
+		if intent == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"intent_name":    aws.StringValue(intent.IntentName),
+			"intent_version": aws.StringValue(intent.IntentVersion),
+		})
+	}
+
+	return l
+}
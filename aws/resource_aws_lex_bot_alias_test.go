@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	var alias lexmodelbuildingservice.GetBotAliasOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotAliasConfig_basic(rName, "Testing alias", "$LATEST"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &alias),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "lex", fmt.Sprintf("bot:%[1]s:%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "bot_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", lexVersionLatest),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing alias"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsLexBotAliasConfig_basic(rName, "Testing published version", "${aws_lex_bot.test.version}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Testing published version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotAliasExists(resourceName string, alias *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot Alias ID is set")
+		}
+
+		botName, name, err := decodeLexBotAliasID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*alias = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		botName, name, err := decodeLexBotAliasID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot Alias (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotAliasConfig_basic(rName, description, botVersion string) string {
+	return testAccAwsLexBotConfigIntent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  child_directed = false
+  create_version = true
+  name           = %[1]q
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "%[3]s"
+  description = %[2]q
+  name        = %[1]q
+}
+`, rName, description, botVersion)
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "abort_statement.#", "1"),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "lex", fmt.Sprintf("bot:%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "clarification_prompt.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "locale", lexmodelbuildingservice.LocaleEnUs),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorSave),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusNotBuilt),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version", "process_behavior"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_Build(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexBotConfig_Build(rName, "Bot to order flowers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "clarification_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Bot to order flowers"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "process_behavior", lexmodelbuildingservice.ProcessBehaviorBuild),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "voice_id", "Salli"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version", "process_behavior"},
+			},
+			{
+				Config: testAccAwsLexBotConfig_Build(rName, "Bot to order flowers and plants"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "description", "Bot to order flowers and plants"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexBotExists(resourceName string, bot *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*bot = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexBotConfigIntent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  create_version    = true
+  name              = %[1]q
+  sample_utterances = ["I would like to pick up flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexBotConfig_basic(rName string) string {
+	return testAccAwsLexBotConfigIntent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  child_directed = false
+  name           = %[1]q
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexBotConfig_Build(rName, description string) string {
+	return testAccAwsLexBotConfigIntent(rName) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  child_directed              = false
+  create_version              = true
+  description                 = %[2]q
+  idle_session_ttl_in_seconds = 600
+  name                        = %[1]q
+  process_behavior            = "BUILD"
+  voice_id                    = "Salli"
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time"
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, description)
+}
@@ -0,0 +1,786 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLexVersionCustomizeDiff(
+			"conclusion_statement",
+			"confirmation_prompt",
+			"description",
+			"dialog_code_hook",
+			"follow_up_prompt",
+			"fulfillment_activity",
+			"parent_intent_signature",
+			"rejection_statement",
+			"sample_utterances",
+			"slot",
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute),
+			Update: schema.DefaultTimeout(time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          lexStatementResource(),
+				ConflictsWith: []string{"follow_up_prompt"},
+			},
+			"confirmation_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexPromptResource(),
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexCodeHookResource(),
+			},
+			"follow_up_prompt": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"conclusion_statement"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource(),
+						},
+						"rejection_statement": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem:     lexStatementResource(),
+						},
+					},
+				},
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexCodeHookResource(),
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+								lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName,
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     lexStatementResource(),
+			},
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "",
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"response_card": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50000),
+						},
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.SlotConstraintOptional,
+								lexmodelbuildingservice.SlotConstraintRequired,
+							}, false),
+						},
+						"slot_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"slot_type_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateLexVersion,
+						},
+						"value_elicitation_prompt": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     lexPromptResource(),
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexIntentInput(d)
+	input.Name = aws.String(name)
+
+	log.Printf("[DEBUG] Creating Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutIntent(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating Lex Intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	intentARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "lex",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("intent:%s", d.Id()),
+	}.String()
+	d.Set("arn", intentARN)
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+
+	if err := d.Set("conclusion_statement", flattenLexStatement(output.ConclusionStatement)); err != nil {
+		return fmt.Errorf("error setting conclusion_statement: %s", err)
+	}
+
+	if err := d.Set("confirmation_prompt", flattenLexPrompt(output.ConfirmationPrompt)); err != nil {
+		return fmt.Errorf("error setting confirmation_prompt: %s", err)
+	}
+
+	if err := d.Set("dialog_code_hook", flattenLexCodeHook(output.DialogCodeHook)); err != nil {
+		return fmt.Errorf("error setting dialog_code_hook: %s", err)
+	}
+
+	if err := d.Set("follow_up_prompt", flattenLexFollowUpPrompt(output.FollowUpPrompt)); err != nil {
+		return fmt.Errorf("error setting follow_up_prompt: %s", err)
+	}
+
+	if err := d.Set("fulfillment_activity", flattenLexFulfillmentActivity(output.FulfillmentActivity)); err != nil {
+		return fmt.Errorf("error setting fulfillment_activity: %s", err)
+	}
+
+	if err := d.Set("rejection_statement", flattenLexStatement(output.RejectionStatement)); err != nil {
+		return fmt.Errorf("error setting rejection_statement: %s", err)
+	}
+
+	if err := d.Set("sample_utterances", flattenStringSet(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %s", err)
+	}
+
+	if err := d.Set("slot", flattenLexSlots(output.Slots)); err != nil {
+		return fmt.Errorf("error setting slot: %s", err)
+	}
+
+	version, err := getLatestLexIntentVersion(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := expandLexIntentInput(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+	input.Name = aws.String(d.Id())
+
+	log.Printf("[DEBUG] Updating Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutIntent(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error updating Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteIntentInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Intent: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteIntent(input)
+
+		// Bots that were just deleted can still be reported as referencing the intent.
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteIntent(input)
+	}
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getLatestLexIntentVersion returns the highest published version of an intent,
+// or $LATEST if no version has been published.
+func getLatestLexIntentVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			versions = append(versions, aws.StringValue(intent.Version))
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return latestLexVersion(versions), nil
+}
+
+func expandLexIntentInput(d *schema.ResourceData) *lexmodelbuildingservice.PutIntentInput {
+	input := &lexmodelbuildingservice.PutIntentInput{
+		ConclusionStatement: expandLexStatement(d.Get("conclusion_statement").([]interface{})),
+		ConfirmationPrompt:  expandLexPrompt(d.Get("confirmation_prompt").([]interface{})),
+		CreateVersion:       aws.Bool(d.Get("create_version").(bool)),
+		Description:         aws.String(d.Get("description").(string)),
+		DialogCodeHook:      expandLexCodeHook(d.Get("dialog_code_hook").([]interface{})),
+		FollowUpPrompt:      expandLexFollowUpPrompt(d.Get("follow_up_prompt").([]interface{})),
+		FulfillmentActivity: expandLexFulfillmentActivity(d.Get("fulfillment_activity").([]interface{})),
+		RejectionStatement:  expandLexStatement(d.Get("rejection_statement").([]interface{})),
+		Slots:               expandLexSlots(d.Get("slot").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_utterances"); ok && v.(*schema.Set).Len() > 0 {
+		input.SampleUtterances = expandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
+
+func lexMessageResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"content_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ContentTypeCustomPayload,
+					lexmodelbuildingservice.ContentTypePlainText,
+					lexmodelbuildingservice.ContentTypeSsml,
+				}, false),
+			},
+			"group_number": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+		},
+	}
+}
+
+func lexPromptResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"message": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem:     lexMessageResource(),
+			},
+			"response_card": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50000),
+			},
+		},
+	}
+}
+
+func lexStatementResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 15,
+				Elem:     lexMessageResource(),
+			},
+			"response_card": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50000),
+			},
+		},
+	}
+}
+
+func lexCodeHookResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"message_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 5),
+			},
+			"uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func expandLexMessages(l []interface{}) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(m["content"].(string)),
+			ContentType: aws.String(m["content_type"].(string)),
+		}
+
+		if v, ok := m["group_number"].(int); ok && v > 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) []interface{} {
+	l := make([]interface{}, 0, len(messages))
+
+	for _, message := range messages {
+		if message == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+			"group_number": int(aws.Int64Value(message.GroupNumber)),
+		})
+	}
+
+	return l
+}
+
+func expandLexPrompt(l []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(m["max_attempts"].(int))),
+		Messages:    expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"max_attempts":  int(aws.Int64Value(prompt.MaxAttempts)),
+		"message":       flattenLexMessages(prompt.Messages),
+		"response_card": aws.StringValue(prompt.ResponseCard),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLexStatement(l []interface{}) *lexmodelbuildingservice.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(m["message"].(*schema.Set).List()),
+	}
+
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message":       flattenLexMessages(statement.Messages),
+		"response_card": aws.StringValue(statement.ResponseCard),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLexCodeHook(l []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []interface{} {
+	if codeHook == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"message_version": aws.StringValue(codeHook.MessageVersion),
+		"uri":             aws.StringValue(codeHook.Uri),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLexFollowUpPrompt(l []interface{}) *lexmodelbuildingservice.FollowUpPrompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FollowUpPrompt{
+		Prompt:             expandLexPrompt(m["prompt"].([]interface{})),
+		RejectionStatement: expandLexStatement(m["rejection_statement"].([]interface{})),
+	}
+}
+
+func flattenLexFollowUpPrompt(followUpPrompt *lexmodelbuildingservice.FollowUpPrompt) []interface{} {
+	if followUpPrompt == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"prompt":              flattenLexPrompt(followUpPrompt.Prompt),
+		"rejection_statement": flattenLexStatement(followUpPrompt.RejectionStatement),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLexFulfillmentActivity(l []interface{}) *lexmodelbuildingservice.FulfillmentActivity {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &lexmodelbuildingservice.FulfillmentActivity{
+		CodeHook: expandLexCodeHook(m["code_hook"].([]interface{})),
+		Type:     aws.String(m["type"].(string)),
+	}
+}
+
+func flattenLexFulfillmentActivity(fulfillmentActivity *lexmodelbuildingservice.FulfillmentActivity) []interface{} {
+	if fulfillmentActivity == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"code_hook": flattenLexCodeHook(fulfillmentActivity.CodeHook),
+		"type":      aws.StringValue(fulfillmentActivity.Type),
+	}
+
+	return []interface{}{m}
+}
+
+func expandLexSlots(l []interface{}) []*lexmodelbuildingservice.Slot {
+	slots := make([]*lexmodelbuildingservice.Slot, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		slot := &lexmodelbuildingservice.Slot{
+			Description:            aws.String(m["description"].(string)),
+			Name:                   aws.String(m["name"].(string)),
+			Priority:               aws.Int64(int64(m["priority"].(int))),
+			SlotConstraint:         aws.String(m["slot_constraint"].(string)),
+			SlotType:               aws.String(m["slot_type"].(string)),
+			ValueElicitationPrompt: expandLexPrompt(m["value_elicitation_prompt"].([]interface{})),
+		}
+
+		if v, ok := m["response_card"].(string); ok && v != "" {
+			slot.ResponseCard = aws.String(v)
+		}
+
+		if v, ok := m["sample_utterances"].([]interface{}); ok && len(v) > 0 {
+			slot.SampleUtterances = expandStringList(v)
+		}
+
+		if v, ok := m["slot_type_version"].(string); ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v)
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) []interface{} {
+	l := make([]interface{}, 0, len(slots))
+
+	for _, slot := range slots {
+		if slot == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"description":              aws.StringValue(slot.Description),
+			"name":                     aws.StringValue(slot.Name),
+			"priority":                 int(aws.Int64Value(slot.Priority)),
+			"response_card":            aws.StringValue(slot.ResponseCard),
+			"sample_utterances":        flattenStringList(slot.SampleUtterances),
+			"slot_constraint":          aws.StringValue(slot.SlotConstraint),
+			"slot_type":                aws.StringValue(slot.SlotType),
+			"slot_type_version":        aws.StringValue(slot.SlotTypeVersion),
+			"value_elicitation_prompt": flattenLexPrompt(slot.ValueElicitationPrompt),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,279 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "lex", fmt.Sprintf("intent:%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "conclusion_statement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "dialog_code_hook.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "follow_up_prompt.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_CreateVersion(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfig_CreateVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_Slots(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexIntentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "0"),
+				),
+			},
+			{
+				Config: testAccAwsLexIntentConfig_Slots(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "conclusion_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Intent to order a bouquet of flowers"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexIntentExists(resourceName string, intent *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Intent ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*intent = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Intent (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexIntentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name              = %[1]q
+  sample_utterances = ["I would like to pick up flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexIntentConfig_CreateVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  create_version    = true
+  name              = %[1]q
+  sample_utterances = ["I would like to pick up flowers"]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexIntentConfig_Slots(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name = %[1]q
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "Lilies"
+  }
+
+  enumeration_value {
+    synonyms = ["Eduardoregelia", "Podonix"]
+    value    = "Tulips"
+  }
+}
+
+resource "aws_lex_intent" "test" {
+  description = "Intent to order a bouquet of flowers"
+  name        = %[1]q
+
+  sample_utterances = [
+    "I would like to order some flowers",
+    "I would like to pick up flowers",
+  ]
+
+  conclusion_statement {
+    message {
+      content      = "Okay, your {FlowerType} will be ready for pickup."
+      content_type = "PlainText"
+    }
+  }
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  slot {
+    description       = "The type of flowers to pick up"
+    name              = "FlowerType"
+    priority          = 1
+    sample_utterances = ["I would like to order {FlowerType}"]
+    slot_constraint   = "Required"
+    slot_type         = "${aws_lex_slot_type.test.name}"
+    slot_type_version = "$LATEST"
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName)
+}
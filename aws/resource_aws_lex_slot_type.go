@@ -0,0 +1,367 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexVersionLatest is the version of the unpublished draft of a Lex bot, intent or slot type.
+const lexVersionLatest = "$LATEST"
+
+func resourceAwsLexSlotType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexSlotTypeCreate,
+		Read:   resourceAwsLexSlotTypeRead,
+		Update: resourceAwsLexSlotTypeUpdate,
+		Delete: resourceAwsLexSlotTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsLexVersionCustomizeDiff(
+			"description",
+			"enumeration_value",
+			"value_selection_strategy",
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute),
+			Update: schema.DefaultTimeout(time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"enumeration_value": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"synonyms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 140),
+							},
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 140),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName,
+			},
+			"value_selection_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue,
+					lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution,
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAwsLexVersionCustomizeDiff returns a CustomizeDiffFunc that marks
+// "version" as computed when a new version will be published on update, i.e.
+// when create_version is set and any of the versioned attributes change.
+func resourceAwsLexVersionCustomizeDiff(versionedAttributes ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Id() == "" || !diff.Get("create_version").(bool) {
+			return nil
+		}
+
+		for _, k := range versionedAttributes {
+			if diff.HasChange(k) {
+				return diff.SetNewComputed("version")
+			}
+		}
+
+		return nil
+	}
+}
+
+var validateLexName = validation.All(
+	validation.StringLenBetween(1, 100),
+	validation.StringMatch(regexp.MustCompile(`^([A-Za-z]_?)+$`), "must contain only letters, optionally separated by single underscores"),
+)
+
+var validateLexVersion = validation.StringMatch(regexp.MustCompile(`^(\$LATEST|[0-9]+)$`), "must be $LATEST or a version number")
+
+func resourceAwsLexSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		CreateVersion:          aws.Bool(d.Get("create_version").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutSlotType(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating Lex Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("value_selection_strategy", output.ValueSelectionStrategy)
+
+	if err := d.Set("enumeration_value", flattenLexEnumerationValues(output.EnumerationValues)); err != nil {
+		return fmt.Errorf("error setting enumeration_value: %s", err)
+	}
+
+	version, err := getLatestLexSlotTypeVersion(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Slot Type (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsLexSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.PutSlotTypeInput{
+		Checksum:               aws.String(d.Get("checksum").(string)),
+		CreateVersion:          aws.Bool(d.Get("create_version").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		EnumerationValues:      expandLexEnumerationValues(d.Get("enumeration_value").(*schema.Set).List()),
+		Name:                   aws.String(d.Id()),
+		ValueSelectionStrategy: aws.String(d.Get("value_selection_strategy").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := conn.PutSlotType(input)
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutSlotType(input)
+	}
+	if err != nil {
+		return fmt.Errorf("error updating Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexSlotTypeRead(d, meta)
+}
+
+func resourceAwsLexSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	input := &lexmodelbuildingservice.DeleteSlotTypeInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Lex Slot Type: %s", input)
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteSlotType(input)
+
+		// Intents that were just deleted can still be reported as referencing the slot type.
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteSlotType(input)
+	}
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getLatestLexSlotTypeVersion returns the highest published version of a slot type,
+// or $LATEST if no version has been published.
+func getLatestLexSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	input := &lexmodelbuildingservice.GetSlotTypeVersionsInput{
+		Name: aws.String(name),
+	}
+	var versions []string
+
+	err := conn.GetSlotTypeVersionsPages(input, func(page *lexmodelbuildingservice.GetSlotTypeVersionsOutput, lastPage bool) bool {
+		for _, slotType := range page.SlotTypes {
+			versions = append(versions, aws.StringValue(slotType.Version))
+		}
+		return !lastPage
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return latestLexVersion(versions), nil
+}
+
+// latestLexVersion returns the highest numbered version in the list, or $LATEST if there is none.
+func latestLexVersion(versions []string) string {
+	latest := 0
+
+	for _, version := range versions {
+		// $LATEST is included in the list but is not a published version.
+		if v, err := strconv.Atoi(version); err == nil && v > latest {
+			latest = v
+		}
+	}
+
+	if latest == 0 {
+		return lexVersionLatest
+	}
+
+	return strconv.Itoa(latest)
+}
+
+func expandLexEnumerationValues(l []interface{}) []*lexmodelbuildingservice.EnumerationValue {
+	values := make([]*lexmodelbuildingservice.EnumerationValue, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		value := &lexmodelbuildingservice.EnumerationValue{
+			Value: aws.String(m["value"].(string)),
+		}
+
+		if v, ok := m["synonyms"].(*schema.Set); ok && v.Len() > 0 {
+			value.Synonyms = expandStringSet(v)
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func flattenLexEnumerationValues(values []*lexmodelbuildingservice.EnumerationValue) []interface{} {
+	l := make([]interface{}, 0, len(values))
+
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"synonyms": flattenStringSet(value.Synonyms),
+			"value":    aws.StringValue(value.Value),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_CreateVersion(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig_CreateVersion(rName, "Lilies"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "create_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig_CreateVersion(rName, "Roses"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_EnumerationValues(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccHasServicePreCheck("models.lex", t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsLexSlotTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "1"),
+				),
+			},
+			{
+				Config: testAccAwsLexSlotTypeConfig_EnumerationValues(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "description", "Types of flowers to pick up"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyTopResolution),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAwsLexSlotTypeExists(resourceName string, slotType *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Slot Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*slotType = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAwsLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Slot Type (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsLexSlotTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name = %[1]q
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "Lilies"
+  }
+}
+`, rName)
+}
+
+func testAccAwsLexSlotTypeConfig_CreateVersion(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  create_version = true
+  name           = %[1]q
+
+  enumeration_value {
+    value = %[2]q
+  }
+}
+`, rName, value)
+}
+
+func testAccAwsLexSlotTypeConfig_EnumerationValues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  description              = "Types of flowers to pick up"
+  name                     = %[1]q
+  value_selection_strategy = "TOP_RESOLUTION"
+
+  enumeration_value {
+    synonyms = ["Lirium", "Martagon"]
+    value    = "Lilies"
+  }
+
+  enumeration_value {
+    synonyms = ["Eduardoregelia", "Podonix"]
+    value    = "Tulips"
+  }
+}
+`, rName)
+}
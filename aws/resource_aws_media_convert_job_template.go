@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaConvertJobTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertJobTemplateCreate,
+		Read:   resourceAwsMediaConvertJobTemplateRead,
+		Update: resourceAwsMediaConvertJobTemplateUpdate,
+		Delete: resourceAwsMediaConvertJobTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acceleration_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.AccelerationModeDisabled,
+								mediaconvert.AccelerationModeEnabled,
+							}, false),
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(-50, 50),
+			},
+			"queue": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"settings_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressAwsSdkSettingsJsonDiffs(func() interface{} { return &mediaconvert.JobTemplateSettings{} }),
+			},
+			"status_update_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  mediaconvert.StatusUpdateIntervalSeconds60,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.StatusUpdateIntervalSeconds10,
+					mediaconvert.StatusUpdateIntervalSeconds12,
+					mediaconvert.StatusUpdateIntervalSeconds15,
+					mediaconvert.StatusUpdateIntervalSeconds20,
+					mediaconvert.StatusUpdateIntervalSeconds30,
+					mediaconvert.StatusUpdateIntervalSeconds60,
+					mediaconvert.StatusUpdateIntervalSeconds120,
+					mediaconvert.StatusUpdateIntervalSeconds180,
+					mediaconvert.StatusUpdateIntervalSeconds240,
+					mediaconvert.StatusUpdateIntervalSeconds300,
+					mediaconvert.StatusUpdateIntervalSeconds360,
+					mediaconvert.StatusUpdateIntervalSeconds420,
+					mediaconvert.StatusUpdateIntervalSeconds480,
+					mediaconvert.StatusUpdateIntervalSeconds540,
+					mediaconvert.StatusUpdateIntervalSeconds600,
+				}, false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	settings, err := expandMediaConvertJobTemplateSettings(d.Get("settings_json").(string))
+	if err != nil {
+		return err
+	}
+
+	input := &mediaconvert.CreateJobTemplateInput{
+		Name:                 aws.String(name),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Settings:             settings,
+		StatusUpdateInterval: aws.String(d.Get("status_update_interval").(string)),
+		Tags:                 keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MediaconvertTags(),
+	}
+
+	if v, ok := d.GetOk("acceleration_settings"); ok {
+		input.AccelerationSettings = expandMediaConvertAccelerationSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("queue"); ok {
+		input.Queue = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Job Template: %s", input)
+	if _, err := conn.CreateJobTemplate(input); err != nil {
+		return fmt.Errorf("error creating MediaConvert Job Template (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	jobTemplate := output.JobTemplate
+
+	if jobTemplate == nil {
+		log.Printf("[WARN] MediaConvert Job Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("acceleration_settings", flattenMediaConvertAccelerationSettings(jobTemplate.AccelerationSettings)); err != nil {
+		return fmt.Errorf("error setting acceleration_settings: %s", err)
+	}
+
+	d.Set("arn", jobTemplate.Arn)
+	d.Set("category", jobTemplate.Category)
+	d.Set("description", jobTemplate.Description)
+	d.Set("name", jobTemplate.Name)
+	d.Set("priority", jobTemplate.Priority)
+	d.Set("queue", jobTemplate.Queue)
+	d.Set("status_update_interval", jobTemplate.StatusUpdateInterval)
+
+	settingsJSON, err := flattenAwsSdkSettingsJsonWithConfigured(jobTemplate.Settings, d.Get("settings_json").(string), func() interface{} { return &mediaconvert.JobTemplateSettings{} })
+	if err != nil {
+		return fmt.Errorf("error flattening MediaConvert Job Template (%s) settings: %s", d.Id(), err)
+	}
+
+	d.Set("settings_json", settingsJSON)
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, aws.StringValue(jobTemplate.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("acceleration_settings") || d.HasChange("category") || d.HasChange("description") ||
+		d.HasChange("priority") || d.HasChange("queue") || d.HasChange("settings_json") || d.HasChange("status_update_interval") {
+		settings, err := expandMediaConvertJobTemplateSettings(d.Get("settings_json").(string))
+		if err != nil {
+			return err
+		}
+
+		input := &mediaconvert.UpdateJobTemplateInput{
+			AccelerationSettings: expandMediaConvertAccelerationSettings(d.Get("acceleration_settings").([]interface{})),
+			Category:             aws.String(d.Get("category").(string)),
+			Description:          aws.String(d.Get("description").(string)),
+			Name:                 aws.String(d.Id()),
+			Priority:             aws.Int64(int64(d.Get("priority").(int))),
+			Settings:             settings,
+			StatusUpdateInterval: aws.String(d.Get("status_update_interval").(string)),
+		}
+
+		if v, ok := d.GetOk("queue"); ok {
+			input.Queue = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Job Template: %s", input)
+		if _, err := conn.UpdateJobTemplate(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Job Template (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Job Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertJobTemplateRead(d, meta)
+}
+
+func resourceAwsMediaConvertJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Job Template: %s", d.Id())
+	_, err = conn.DeleteJobTemplate(&mediaconvert.DeleteJobTemplateInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Job Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaConvertJobTemplateSettings(rawSettings string) (*mediaconvert.JobTemplateSettings, error) {
+	settings := &mediaconvert.JobTemplateSettings{}
+
+	if err := json.Unmarshal([]byte(rawSettings), settings); err != nil {
+		return nil, fmt.Errorf("error decoding MediaConvert Job Template settings JSON: %s", err)
+	}
+
+	return settings, nil
+}
+
+func expandMediaConvertAccelerationSettings(l []interface{}) *mediaconvert.AccelerationSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &mediaconvert.AccelerationSettings{
+		Mode: aws.String(m["mode"].(string)),
+	}
+}
+
+func flattenMediaConvertAccelerationSettings(accelerationSettings *mediaconvert.AccelerationSettings) []interface{} {
+	if accelerationSettings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"mode": aws.StringValue(accelerationSettings.Mode),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaConvertJobTemplate_basic(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	queueResourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateConfig_Basic(rName, 0, mediaconvert.StatusUpdateIntervalSeconds60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`jobTemplates/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue", queueResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds60),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_json"},
+			},
+			{
+				Config: testAccMediaConvertJobTemplateConfig_Basic(rName, 10, mediaconvert.StatusUpdateIntervalSeconds120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status_update_interval", mediaconvert.StatusUpdateIntervalSeconds120),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertJobTemplate_Tags(t *testing.T) {
+	var jobTemplate mediaconvert.JobTemplate
+	resourceName := "aws_media_convert_job_template.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertJobTemplateConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_json"},
+			},
+			{
+				Config: testAccMediaConvertJobTemplateConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaConvertJobTemplateConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertJobTemplateExists(resourceName, &jobTemplate),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertJobTemplateDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_job_template" {
+			continue
+		}
+
+		_, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Job Template (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertJobTemplateExists(resourceName string, jobTemplate *mediaconvert.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Job Template ID is set")
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetJobTemplate(&mediaconvert.GetJobTemplateInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.JobTemplate == nil {
+			return fmt.Errorf("MediaConvert Job Template (%s) not found", rs.Primary.ID)
+		}
+
+		*jobTemplate = *output.JobTemplate
+
+		return nil
+	}
+}
+
+func testAccMediaConvertJobTemplateConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings_json = <<EOF
+%[2]s
+EOF
+}
+`, rName, testAccMediaConvertPresetConfigSettingsJSON("96000"))
+}
+
+func testAccMediaConvertJobTemplateConfigSettingsJSON() string {
+	return `
+{
+  "OutputGroups": [
+    {
+      "Name": "File Group",
+      "OutputGroupSettings": {
+        "Type": "FILE_GROUP_SETTINGS",
+        "FileGroupSettings": {}
+      },
+      "Outputs": [
+        {
+          "Preset": "${aws_media_convert_preset.test.name}"
+        }
+      ]
+    }
+  ]
+}
+`
+}
+
+func testAccMediaConvertJobTemplateConfig_Basic(rName string, priority int, statusUpdateInterval string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name                   = %[1]q
+  priority               = %[2]d
+  queue                  = "${aws_media_convert_queue.test.arn}"
+  status_update_interval = %[3]q
+
+  settings_json = <<EOF
+%[4]s
+EOF
+}
+`, rName, priority, statusUpdateInterval, testAccMediaConvertJobTemplateConfigSettingsJSON())
+}
+
+func testAccMediaConvertJobTemplateConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+
+  settings_json = <<EOF
+%[4]s
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccMediaConvertJobTemplateConfigSettingsJSON())
+}
+
+func testAccMediaConvertJobTemplateConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccMediaConvertJobTemplateConfigBase(rName) + fmt.Sprintf(`
+resource "aws_media_convert_job_template" "test" {
+  name = %[1]q
+
+  settings_json = <<EOF
+%[6]s
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccMediaConvertJobTemplateConfigSettingsJSON())
+}
@@ -0,0 +1,213 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaConvertPreset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertPresetCreate,
+		Read:   resourceAwsMediaConvertPresetRead,
+		Update: resourceAwsMediaConvertPresetUpdate,
+		Delete: resourceAwsMediaConvertPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"settings_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressAwsSdkSettingsJsonDiffs(func() interface{} { return &mediaconvert.PresetSettings{} }),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertPresetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	settings, err := expandMediaConvertPresetSettings(d.Get("settings_json").(string))
+	if err != nil {
+		return err
+	}
+
+	input := &mediaconvert.CreatePresetInput{
+		Name:     aws.String(name),
+		Settings: settings,
+		Tags:     keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MediaconvertTags(),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Preset: %s", input)
+	if _, err := conn.CreatePreset(input); err != nil {
+		return fmt.Errorf("error creating MediaConvert Preset (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	preset := output.Preset
+
+	if preset == nil {
+		log.Printf("[WARN] MediaConvert Preset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", preset.Arn)
+	d.Set("category", preset.Category)
+	d.Set("description", preset.Description)
+	d.Set("name", preset.Name)
+
+	settingsJSON, err := flattenAwsSdkSettingsJsonWithConfigured(preset.Settings, d.Get("settings_json").(string), func() interface{} { return &mediaconvert.PresetSettings{} })
+	if err != nil {
+		return fmt.Errorf("error flattening MediaConvert Preset (%s) settings: %s", d.Id(), err)
+	}
+
+	d.Set("settings_json", settingsJSON)
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, aws.StringValue(preset.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertPresetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("category") || d.HasChange("description") || d.HasChange("settings_json") {
+		settings, err := expandMediaConvertPresetSettings(d.Get("settings_json").(string))
+		if err != nil {
+			return err
+		}
+
+		input := &mediaconvert.UpdatePresetInput{
+			Category:    aws.String(d.Get("category").(string)),
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Settings:    settings,
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Preset: %s", input)
+		if _, err := conn.UpdatePreset(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Preset (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Preset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertPresetRead(d, meta)
+}
+
+func resourceAwsMediaConvertPresetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Preset: %s", d.Id())
+	_, err = conn.DeletePreset(&mediaconvert.DeletePresetInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Preset (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMediaConvertPresetSettings(rawSettings string) (*mediaconvert.PresetSettings, error) {
+	settings := &mediaconvert.PresetSettings{}
+
+	if err := json.Unmarshal([]byte(rawSettings), settings); err != nil {
+		return nil, fmt.Errorf("error decoding MediaConvert Preset settings JSON: %s", err)
+	}
+
+	return settings, nil
+}
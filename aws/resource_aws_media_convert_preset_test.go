@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaConvertPreset_basic(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertPresetConfig_Basic(rName, "description1", "96000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`presets/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "category", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "settings_json"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_json"},
+			},
+			{
+				Config: testAccMediaConvertPresetConfig_Basic(rName, "description2", "128000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestMatchResourceAttr(resourceName, "settings_json", regexp.MustCompile(`"bitrate":128000`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertPreset_Tags(t *testing.T) {
+	var preset mediaconvert.Preset
+	resourceName := "aws_media_convert_preset.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertPresetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertPresetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_json"},
+			},
+			{
+				Config: testAccMediaConvertPresetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaConvertPresetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertPresetExists(resourceName, &preset),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertPresetDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_preset" {
+			continue
+		}
+
+		_, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Preset (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertPresetExists(resourceName string, preset *mediaconvert.Preset) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Preset ID is set")
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetPreset(&mediaconvert.GetPresetInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Preset == nil {
+			return fmt.Errorf("MediaConvert Preset (%s) not found", rs.Primary.ID)
+		}
+
+		*preset = *output.Preset
+
+		return nil
+	}
+}
+
+func testAccMediaConvertPresetConfigSettingsJSON(bitrate string) string {
+	return fmt.Sprintf(`
+{
+  "AudioDescriptions": [
+    {
+      "AudioSourceName": "Audio Selector 1",
+      "CodecSettings": {
+        "Codec": "AAC",
+        "AacSettings": {
+          "Bitrate": %[1]s,
+          "CodingMode": "CODING_MODE_2_0",
+          "SampleRate": 48000
+        }
+      }
+    }
+  ],
+  "ContainerSettings": {
+    "Container": "MP4",
+    "Mp4Settings": {}
+  }
+}
+`, bitrate)
+}
+
+func testAccMediaConvertPresetConfig_Basic(rName, description, bitrate string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  description = %[2]q
+  name        = %[1]q
+
+  settings_json = <<EOF
+%[3]s
+EOF
+}
+`, rName, description, testAccMediaConvertPresetConfigSettingsJSON(bitrate))
+}
+
+func testAccMediaConvertPresetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings_json = <<EOF
+%[4]s
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1, testAccMediaConvertPresetConfigSettingsJSON("96000"))
+}
+
+func testAccMediaConvertPresetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_preset" "test" {
+  name = %[1]q
+
+  settings_json = <<EOF
+%[6]s
+EOF
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2, testAccMediaConvertPresetConfigSettingsJSON("96000"))
+}
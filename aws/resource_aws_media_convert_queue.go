@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaConvertQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConvertQueueCreate,
+		Read:   resourceAwsMediaConvertQueueRead,
+		Update: resourceAwsMediaConvertQueueUpdate,
+		Delete: resourceAwsMediaConvertQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pricing_plan": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  mediaconvert.PricingPlanOnDemand,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.PricingPlanOnDemand,
+					mediaconvert.PricingPlanReserved,
+				}, false),
+			},
+			"reservation_plan_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commitment": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.CommitmentOneYear,
+							}, false),
+						},
+						"renewal_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								mediaconvert.RenewalTypeAutoRenew,
+								mediaconvert.RenewalTypeExpire,
+							}, false),
+						},
+						"reserved_slots": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  mediaconvert.QueueStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					mediaconvert.QueueStatusActive,
+					mediaconvert.QueueStatusPaused,
+				}, false),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaConvertQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	input := &mediaconvert.CreateQueueInput{
+		Name:        aws.String(name),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		Status:      aws.String(d.Get("status").(string)),
+		Tags:        keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MediaconvertTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("reservation_plan_settings"); ok {
+		input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConvert Queue: %s", input)
+	if _, err := conn.CreateQueue(input); err != nil {
+		return fmt.Errorf("error creating MediaConvert Queue (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueRead(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConvert Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	queue := output.Queue
+
+	if queue == nil {
+		log.Printf("[WARN] MediaConvert Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", queue.Arn)
+	d.Set("description", queue.Description)
+	d.Set("name", queue.Name)
+	d.Set("pricing_plan", queue.PricingPlan)
+	d.Set("status", queue.Status)
+
+	if err := d.Set("reservation_plan_settings", flattenMediaConvertReservationPlan(queue.ReservationPlan)); err != nil {
+		return fmt.Errorf("error setting reservation_plan_settings: %s", err)
+	}
+
+	tags, err := keyvaluetags.MediaconvertListTags(conn, aws.StringValue(queue.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConvertQueueUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("description") || d.HasChange("reservation_plan_settings") || d.HasChange("status") {
+		input := &mediaconvert.UpdateQueueInput{
+			Description: aws.String(d.Get("description").(string)),
+			Name:        aws.String(d.Id()),
+			Status:      aws.String(d.Get("status").(string)),
+		}
+
+		if d.Get("pricing_plan").(string) == mediaconvert.PricingPlanReserved {
+			input.ReservationPlanSettings = expandMediaConvertReservationPlanSettings(d.Get("reservation_plan_settings").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaConvert Queue: %s", input)
+		if _, err := conn.UpdateQueue(input); err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediaconvertUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConvert Queue (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConvertQueueRead(d, meta)
+}
+
+func resourceAwsMediaConvertQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	conn, err := getAwsMediaConvertAccountClient(meta.(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConvert Queue: %s", d.Id())
+	_, err = conn.DeleteQueue(&mediaconvert.DeleteQueueInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConvert Queue (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// getAwsMediaConvertAccountClient returns a MediaConvert client using the
+// account-specific endpoint discovered via DescribeEndpoints, which all
+// MediaConvert API calls other than DescribeEndpoints require.
+func getAwsMediaConvertAccountClient(awsClient *AWSClient) (*mediaconvert.MediaConvert, error) {
+	const mutexKey = `mediaconvertaccountconn`
+	awsMutexKV.Lock(mutexKey)
+	defer awsMutexKV.Unlock(mutexKey)
+
+	if awsClient.mediaconvertaccountconn != nil {
+		return awsClient.mediaconvertaccountconn, nil
+	}
+
+	input := &mediaconvert.DescribeEndpointsInput{
+		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+	}
+
+	output, err := awsClient.mediaconvertconn.DescribeEndpoints(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %s", err)
+	}
+
+	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
+		return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
+	}
+
+	endpoint := aws.StringValue(output.Endpoints[0].Url)
+
+	sess, err := session.NewSession(&awsClient.mediaconvertconn.Config)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS MediaConvert session: %s", err)
+	}
+
+	conn := mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)}))
+
+	awsClient.mediaconvertaccountconn = conn
+
+	return conn, nil
+}
+
+func expandMediaConvertReservationPlanSettings(l []interface{}) *mediaconvert.ReservationPlanSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &mediaconvert.ReservationPlanSettings{
+		Commitment:    aws.String(m["commitment"].(string)),
+		RenewalType:   aws.String(m["renewal_type"].(string)),
+		ReservedSlots: aws.Int64(int64(m["reserved_slots"].(int))),
+	}
+}
+
+func flattenMediaConvertReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []interface{} {
+	if reservationPlan == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"commitment":     aws.StringValue(reservationPlan.Commitment),
+		"renewal_type":   aws.StringValue(reservationPlan.RenewalType),
+		"reserved_slots": int(aws.Int64Value(reservationPlan.ReservedSlots)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,247 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaConvertQueue_basic(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfig_Basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediaconvert", regexp.MustCompile(`queues/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pricing_plan", mediaconvert.PricingPlanOnDemand),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_WithStatusAndDescription(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfig_WithStatusAndDescription(rName, mediaconvert.QueueStatusPaused, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusPaused),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertQueueConfig_WithStatusAndDescription(rName, mediaconvert.QueueStatusActive, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconvert.QueueStatusActive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConvertQueue_Tags(t *testing.T) {
+	var queue mediaconvert.Queue
+	resourceName := "aws_media_convert_queue.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConvert(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConvertQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConvertQueueConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConvertQueueConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaConvertQueueConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConvertQueueExists(resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConvertQueueDestroy(s *terraform.State) error {
+	conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_media_convert_queue" {
+			continue
+		}
+
+		_, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconvert.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaConvert Queue (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConvertQueueExists(resourceName string, queue *mediaconvert.Queue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConvert Queue ID is set")
+		}
+
+		conn, err := getAwsMediaConvertAccountClient(testAccProvider.Meta().(*AWSClient))
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetQueue(&mediaconvert.GetQueueInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Queue == nil {
+			return fmt.Errorf("MediaConvert Queue (%s) not found", rs.Primary.ID)
+		}
+
+		*queue = *output.Queue
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSMediaConvert(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).mediaconvertconn
+
+	input := &mediaconvert.DescribeEndpointsInput{
+		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+	}
+
+	_, err := conn.DescribeEndpoints(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccMediaConvertQueueConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccMediaConvertQueueConfig_WithStatusAndDescription(rName, status, description string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  description = %[3]q
+  name        = %[1]q
+  status      = %[2]q
+}
+`, rName, status, description)
+}
+
+func testAccMediaConvertQueueConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMediaConvertQueueConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_media_convert_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
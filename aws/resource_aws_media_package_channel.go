@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMediaPackageChannel() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -66,7 +69,8 @@ func resourceAwsMediaPackageChannel() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -79,8 +83,8 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 		Description: aws.String(d.Get("description").(string)),
 	}
 
-	if attr, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapGeneric(attr.(map[string]interface{}))
+	if attr, ok := d.GetOk("tags_all"); ok {
+		input.Tags = keyvaluetags.New(attr.(map[string]interface{})).IgnoreAws().MediapackageTags()
 	}
 
 	_, err := conn.CreateChannel(input)
@@ -94,6 +98,7 @@ func resourceAwsMediaPackageChannelCreate(d *schema.ResourceData, meta interface
 
 func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediapackageconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &mediapackage.DescribeChannelInput{
 		Id: aws.String(d.Id()),
@@ -110,7 +115,7 @@ func resourceAwsMediaPackageChannelRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("error setting hls_ingest: %s", err)
 	}
 
-	if err := d.Set("tags", tagsToMapGeneric(resp.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.MediapackageKeyValueTags(resp.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -130,8 +135,12 @@ func resourceAwsMediaPackageChannelUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error updating MediaPackage Channel: %s", err)
 	}
 
-	if err := setTagsMediaPackage(conn, d, d.Get("arn").(string)); err != nil {
-		return fmt.Errorf("error updating MediaPackage Channel (%s) tags: %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediapackageUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaPackage Channel (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsMediaPackageChannelRead(d, meta)
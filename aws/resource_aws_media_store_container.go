@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMediaStoreContainer() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -37,7 +40,8 @@ func resourceAwsMediaStoreContainer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -47,7 +51,7 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 
 	input := &mediastore.CreateContainerInput{
 		ContainerName: aws.String(d.Get("name").(string)),
-		Tags:          tagsFromMapMediaStore(d.Get("tags").(map[string]interface{})),
+		Tags:          keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MediastoreTags(),
 	}
 
 	_, err := conn.CreateContainer(input)
@@ -74,6 +78,7 @@ func resourceAwsMediaStoreContainerCreate(d *schema.ResourceData, meta interface
 
 func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediastoreconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	input := &mediastore.DescribeContainerInput{
 		ContainerName: aws.String(d.Id()),
@@ -91,7 +96,9 @@ func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}
 	d.Set("name", resp.Container.Name)
 	d.Set("endpoint", resp.Container.Endpoint)
 
-	if err := saveTagsMediaStore(conn, d, aws.StringValue(resp.Container.ARN)); err != nil {
+	tags, err := keyvaluetags.MediastoreListTags(conn, aws.StringValue(resp.Container.ARN))
+
+	if err != nil {
 		if isAWSErr(err, mediastore.ErrCodeContainerNotFoundException, "") {
 			log.Printf("[WARN] No Container found: %s, removing from state", d.Id())
 			d.SetId("")
@@ -100,19 +107,27 @@ func resourceAwsMediaStoreContainerRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting tags for %s: %s", d.Id(), err)
 	}
 
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsMediaStoreContainerUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mediastoreconn
 
-	if err := setTagsMediaStore(conn, d, d.Get("arn").(string)); err != nil {
-		if isAWSErr(err, mediastore.ErrCodeContainerNotFoundException, "") {
-			log.Printf("[WARN] No Container found: %s, removing from state", d.Id())
-			d.SetId("")
-			return nil
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediastoreUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			if isAWSErr(err, mediastore.ErrCodeContainerNotFoundException, "") {
+				log.Printf("[WARN] No Container found: %s, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 		}
-		return fmt.Errorf("Error updating tags for %s: %s", d.Id(), err)
 	}
 
 	return resourceAwsMediaStoreContainerRead(d, meta)
@@ -0,0 +1,1100 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaConnectFlow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaConnectFlowCreate,
+		Read:   resourceAwsMediaConnectFlowRead,
+		Update: resourceAwsMediaConnectFlowUpdate,
+		Delete: resourceAwsMediaConnectFlowDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"egress_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": mediaConnectEncryptionSchema(),
+						"entitlement_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscribers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAwsAccountId,
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_allow_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"encryption": mediaConnectEncryptionSchema(),
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"output_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateMediaConnectProtocol,
+						},
+						"remote_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"smoothing_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"decryption": mediaConnectEncryptionSchema(),
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"entitlement_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+						"ingest_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ingest_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"max_bitrate": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"max_latency": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateMediaConnectProtocol,
+						},
+						"source_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"whitelist_cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"start_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+var validateMediaConnectProtocol = validation.StringInSlice([]string{
+	mediaconnect.ProtocolRtp,
+	mediaconnect.ProtocolRtpFec,
+	mediaconnect.ProtocolZixiPull,
+	mediaconnect.ProtocolZixiPush,
+}, false)
+
+func mediaConnectEncryptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						mediaconnect.AlgorithmAes128,
+						mediaconnect.AlgorithmAes192,
+						mediaconnect.AlgorithmAes256,
+					}, false),
+				},
+				"constant_initialization_vector": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"device_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  mediaconnect.KeyTypeStaticKey,
+					ValidateFunc: validation.StringInSlice([]string{
+						mediaconnect.KeyTypeSpeke,
+						mediaconnect.KeyTypeStaticKey,
+					}, false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"resource_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+				"secret_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateArn,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsMediaConnectFlowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	name := d.Get("name").(string)
+
+	input := &mediaconnect.CreateFlowInput{
+		Entitlements: expandMediaConnectGrantEntitlementRequests(d.Get("entitlement").([]interface{})),
+		Name:         aws.String(name),
+		Outputs:      expandMediaConnectAddOutputRequests(d.Get("output").([]interface{})),
+		Source:       expandMediaConnectSetSourceRequest(d.Get("source").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaConnect Flow: %s", input)
+	output, err := conn.CreateFlow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaConnect Flow (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Flow.FlowArn))
+
+	if err := waitForMediaConnectFlowStatus(conn, d.Id(), []string{mediaconnect.StatusUpdating}, mediaconnect.StatusStandby, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) creation: %s", d.Id(), err)
+	}
+
+	if v := d.Get("tags_all").(map[string]interface{}); len(v) > 0 {
+		if err := keyvaluetags.MediaconnectUpdateTags(conn, d.Id(), nil, v); err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("start_flow").(bool) {
+		if err := startMediaConnectFlow(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaConnectFlowRead(d, meta)
+}
+
+func resourceAwsMediaConnectFlowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	output, err := conn.DescribeFlow(&mediaconnect.DescribeFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconnect.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	flow := output.Flow
+
+	if flow == nil {
+		log.Printf("[WARN] MediaConnect Flow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	status := aws.StringValue(flow.Status)
+
+	d.Set("arn", flow.FlowArn)
+	d.Set("availability_zone", flow.AvailabilityZone)
+	d.Set("egress_ip", flow.EgressIp)
+
+	if err := d.Set("entitlement", flattenMediaConnectEntitlements(flow.Entitlements, d.Get("entitlement").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting entitlement: %s", err)
+	}
+
+	d.Set("name", flow.Name)
+
+	if err := d.Set("output", flattenMediaConnectOutputs(flow.Outputs, d.Get("output").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting output: %s", err)
+	}
+
+	if err := d.Set("source", flattenMediaConnectSource(flow.Source)); err != nil {
+		return fmt.Errorf("error setting source: %s", err)
+	}
+
+	d.Set("start_flow", status == mediaconnect.StatusActive || status == mediaconnect.StatusStarting)
+	d.Set("status", status)
+
+	tags, err := keyvaluetags.MediaconnectListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaConnectFlowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	if d.HasChange("source") {
+		input := expandMediaConnectUpdateFlowSourceInput(d.Get("source").([]interface{}))
+		input.FlowArn = aws.String(d.Id())
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow source: %s", input)
+		if _, err := conn.UpdateFlowSource(input); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) source: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("entitlement") {
+		o, n := d.GetChange("entitlement")
+
+		if err := updateMediaConnectFlowEntitlements(conn, d.Id(), o.([]interface{}), n.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("output") {
+		o, n := d.GetChange("output")
+
+		if err := updateMediaConnectFlowOutputs(conn, d.Id(), o.([]interface{}), n.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("start_flow") {
+		if d.Get("start_flow").(bool) {
+			if err := startMediaConnectFlow(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			if err := stopMediaConnectFlow(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MediaconnectUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaConnectFlowRead(d, meta)
+}
+
+func resourceAwsMediaConnectFlowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mediaconnectconn
+
+	// Flows must be stopped before they can be deleted.
+	if d.Get("start_flow").(bool) {
+		if err := stopMediaConnectFlow(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaConnect Flow: %s", d.Id())
+	_, err := conn.DeleteFlow(&mediaconnect.DeleteFlowInput{
+		FlowArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, mediaconnect.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaConnect Flow (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForMediaConnectFlowDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateMediaConnectFlowEntitlements(conn *mediaconnect.MediaConnect, flowArn string, o, n []interface{}) error {
+	oldEntitlements := mediaConnectListByName(o)
+	newEntitlements := mediaConnectListByName(n)
+
+	for name, oldEntitlement := range oldEntitlements {
+		if _, ok := newEntitlements[name]; ok {
+			continue
+		}
+
+		entitlementArn := oldEntitlement["entitlement_arn"].(string)
+
+		log.Printf("[DEBUG] Revoking MediaConnect Flow (%s) entitlement: %s", flowArn, entitlementArn)
+		_, err := conn.RevokeFlowEntitlement(&mediaconnect.RevokeFlowEntitlementInput{
+			EntitlementArn: aws.String(entitlementArn),
+			FlowArn:        aws.String(flowArn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error revoking MediaConnect Flow (%s) entitlement (%s): %s", flowArn, entitlementArn, err)
+		}
+	}
+
+	var grants []interface{}
+
+	for _, raw := range n {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		oldEntitlement, ok := oldEntitlements[m["name"].(string)]
+
+		if !ok {
+			grants = append(grants, m)
+			continue
+		}
+
+		if mediaConnectMapsEqual(oldEntitlement, m, "entitlement_arn") {
+			continue
+		}
+
+		input := &mediaconnect.UpdateFlowEntitlementInput{
+			Description:    aws.String(m["description"].(string)),
+			Encryption:     expandMediaConnectUpdateEncryption(m["encryption"].([]interface{})),
+			EntitlementArn: aws.String(oldEntitlement["entitlement_arn"].(string)),
+			FlowArn:        aws.String(flowArn),
+			Subscribers:    expandStringSet(m["subscribers"].(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow entitlement: %s", input)
+		if _, err := conn.UpdateFlowEntitlement(input); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) entitlement (%s): %s", flowArn, aws.StringValue(input.EntitlementArn), err)
+		}
+	}
+
+	if len(grants) > 0 {
+		input := &mediaconnect.GrantFlowEntitlementsInput{
+			Entitlements: expandMediaConnectGrantEntitlementRequests(grants),
+			FlowArn:      aws.String(flowArn),
+		}
+
+		log.Printf("[DEBUG] Granting MediaConnect Flow entitlements: %s", input)
+		if _, err := conn.GrantFlowEntitlements(input); err != nil {
+			return fmt.Errorf("error granting MediaConnect Flow (%s) entitlements: %s", flowArn, err)
+		}
+	}
+
+	return nil
+}
+
+func updateMediaConnectFlowOutputs(conn *mediaconnect.MediaConnect, flowArn string, o, n []interface{}) error {
+	oldOutputs := mediaConnectListByName(o)
+	newOutputs := mediaConnectListByName(n)
+
+	for name, oldOutput := range oldOutputs {
+		if _, ok := newOutputs[name]; ok {
+			continue
+		}
+
+		outputArn := oldOutput["output_arn"].(string)
+
+		log.Printf("[DEBUG] Removing MediaConnect Flow (%s) output: %s", flowArn, outputArn)
+		_, err := conn.RemoveFlowOutput(&mediaconnect.RemoveFlowOutputInput{
+			FlowArn:   aws.String(flowArn),
+			OutputArn: aws.String(outputArn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error removing MediaConnect Flow (%s) output (%s): %s", flowArn, outputArn, err)
+		}
+	}
+
+	var additions []interface{}
+
+	for _, raw := range n {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		oldOutput, ok := oldOutputs[m["name"].(string)]
+
+		if !ok {
+			additions = append(additions, m)
+			continue
+		}
+
+		if mediaConnectMapsEqual(oldOutput, m, "output_arn") {
+			continue
+		}
+
+		input := &mediaconnect.UpdateFlowOutputInput{
+			CidrAllowList: expandStringSet(m["cidr_allow_list"].(*schema.Set)),
+			Description:   aws.String(m["description"].(string)),
+			Encryption:    expandMediaConnectUpdateEncryption(m["encryption"].([]interface{})),
+			FlowArn:       aws.String(flowArn),
+			OutputArn:     aws.String(oldOutput["output_arn"].(string)),
+			Protocol:      aws.String(m["protocol"].(string)),
+		}
+
+		if v, ok := m["destination"].(string); ok && v != "" {
+			input.Destination = aws.String(v)
+		}
+
+		if v, ok := m["max_latency"].(int); ok && v != 0 {
+			input.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["port"].(int); ok && v != 0 {
+			input.Port = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["remote_id"].(string); ok && v != "" {
+			input.RemoteId = aws.String(v)
+		}
+
+		if v, ok := m["smoothing_latency"].(int); ok && v != 0 {
+			input.SmoothingLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["stream_id"].(string); ok && v != "" {
+			input.StreamId = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Updating MediaConnect Flow output: %s", input)
+		if _, err := conn.UpdateFlowOutput(input); err != nil {
+			return fmt.Errorf("error updating MediaConnect Flow (%s) output (%s): %s", flowArn, aws.StringValue(input.OutputArn), err)
+		}
+	}
+
+	if len(additions) > 0 {
+		input := &mediaconnect.AddFlowOutputsInput{
+			FlowArn: aws.String(flowArn),
+			Outputs: expandMediaConnectAddOutputRequests(additions),
+		}
+
+		log.Printf("[DEBUG] Adding MediaConnect Flow outputs: %s", input)
+		if _, err := conn.AddFlowOutputs(input); err != nil {
+			return fmt.Errorf("error adding MediaConnect Flow (%s) outputs: %s", flowArn, err)
+		}
+	}
+
+	return nil
+}
+
+// mediaConnectListByName indexes a list of entitlement or output configuration blocks by name.
+func mediaConnectListByName(l []interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{}, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		result[m["name"].(string)] = m
+	}
+
+	return result
+}
+
+// mediaConnectMapsEqual compares two configuration blocks, ignoring the computed ARN attribute.
+func mediaConnectMapsEqual(o, n map[string]interface{}, arnKey string) bool {
+	for k, v := range n {
+		if k == arnKey {
+			continue
+		}
+
+		if set, ok := v.(*schema.Set); ok {
+			if !set.Equal(o[k]) {
+				return false
+			}
+
+			continue
+		}
+
+		if !reflect.DeepEqual(v, o[k]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func startMediaConnectFlow(conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting MediaConnect Flow: %s", arn)
+	_, err := conn.StartFlow(&mediaconnect.StartFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaConnect Flow (%s): %s", arn, err)
+	}
+
+	if err := waitForMediaConnectFlowStatus(conn, arn, []string{mediaconnect.StatusStandby, mediaconnect.StatusStarting}, mediaconnect.StatusActive, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) to start: %s", arn, err)
+	}
+
+	return nil
+}
+
+func stopMediaConnectFlow(conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping MediaConnect Flow: %s", arn)
+	_, err := conn.StopFlow(&mediaconnect.StopFlowInput{
+		FlowArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaConnect Flow (%s): %s", arn, err)
+	}
+
+	if err := waitForMediaConnectFlowStatus(conn, arn, []string{mediaconnect.StatusActive, mediaconnect.StatusStopping}, mediaconnect.StatusStandby, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaConnect Flow (%s) to stop: %s", arn, err)
+	}
+
+	return nil
+}
+
+func mediaConnectFlowStatusRefreshFunc(conn *mediaconnect.MediaConnect, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeFlow(&mediaconnect.DescribeFlowInput{
+			FlowArn: aws.String(arn),
+		})
+
+		if isAWSErr(err, mediaconnect.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Flow == nil {
+			return nil, "", nil
+		}
+
+		return output.Flow, aws.StringValue(output.Flow.Status), nil
+	}
+}
+
+func waitForMediaConnectFlowStatus(conn *mediaconnect.MediaConnect, arn string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    mediaConnectFlowStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForMediaConnectFlowDeletion(conn *mediaconnect.MediaConnect, arn string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			mediaconnect.StatusDeleting,
+			mediaconnect.StatusStandby,
+		},
+		Target:     []string{},
+		Refresh:    mediaConnectFlowStatusRefreshFunc(conn, arn),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandMediaConnectEncryption(l []interface{}) *mediaconnect.Encryption {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryption := &mediaconnect.Encryption{
+		Algorithm: aws.String(m["algorithm"].(string)),
+		KeyType:   aws.String(m["key_type"].(string)),
+		RoleArn:   aws.String(m["role_arn"].(string)),
+	}
+
+	if v, ok := m["constant_initialization_vector"].(string); ok && v != "" {
+		encryption.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := m["device_id"].(string); ok && v != "" {
+		encryption.DeviceId = aws.String(v)
+	}
+
+	if v, ok := m["region"].(string); ok && v != "" {
+		encryption.Region = aws.String(v)
+	}
+
+	if v, ok := m["resource_id"].(string); ok && v != "" {
+		encryption.ResourceId = aws.String(v)
+	}
+
+	if v, ok := m["secret_arn"].(string); ok && v != "" {
+		encryption.SecretArn = aws.String(v)
+	}
+
+	if v, ok := m["url"].(string); ok && v != "" {
+		encryption.Url = aws.String(v)
+	}
+
+	return encryption
+}
+
+func expandMediaConnectUpdateEncryption(l []interface{}) *mediaconnect.UpdateEncryption {
+	encryption := expandMediaConnectEncryption(l)
+
+	if encryption == nil {
+		return nil
+	}
+
+	return &mediaconnect.UpdateEncryption{
+		Algorithm:                    encryption.Algorithm,
+		ConstantInitializationVector: encryption.ConstantInitializationVector,
+		DeviceId:                     encryption.DeviceId,
+		KeyType:                      encryption.KeyType,
+		Region:                       encryption.Region,
+		ResourceId:                   encryption.ResourceId,
+		RoleArn:                      encryption.RoleArn,
+		SecretArn:                    encryption.SecretArn,
+		Url:                          encryption.Url,
+	}
+}
+
+func expandMediaConnectGrantEntitlementRequests(l []interface{}) []*mediaconnect.GrantEntitlementRequest {
+	var entitlements []*mediaconnect.GrantEntitlementRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entitlement := &mediaconnect.GrantEntitlementRequest{
+			Encryption:  expandMediaConnectEncryption(m["encryption"].([]interface{})),
+			Name:        aws.String(m["name"].(string)),
+			Subscribers: expandStringSet(m["subscribers"].(*schema.Set)),
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			entitlement.Description = aws.String(v)
+		}
+
+		entitlements = append(entitlements, entitlement)
+	}
+
+	return entitlements
+}
+
+func expandMediaConnectAddOutputRequests(l []interface{}) []*mediaconnect.AddOutputRequest {
+	var outputs []*mediaconnect.AddOutputRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		output := &mediaconnect.AddOutputRequest{
+			Encryption: expandMediaConnectEncryption(m["encryption"].([]interface{})),
+			Name:       aws.String(m["name"].(string)),
+			Protocol:   aws.String(m["protocol"].(string)),
+		}
+
+		if v, ok := m["cidr_allow_list"].(*schema.Set); ok && v.Len() > 0 {
+			output.CidrAllowList = expandStringSet(v)
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			output.Description = aws.String(v)
+		}
+
+		if v, ok := m["destination"].(string); ok && v != "" {
+			output.Destination = aws.String(v)
+		}
+
+		if v, ok := m["max_latency"].(int); ok && v != 0 {
+			output.MaxLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["port"].(int); ok && v != 0 {
+			output.Port = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["remote_id"].(string); ok && v != "" {
+			output.RemoteId = aws.String(v)
+		}
+
+		if v, ok := m["smoothing_latency"].(int); ok && v != 0 {
+			output.SmoothingLatency = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["stream_id"].(string); ok && v != "" {
+			output.StreamId = aws.String(v)
+		}
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
+
+func expandMediaConnectSetSourceRequest(l []interface{}) *mediaconnect.SetSourceRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	source := &mediaconnect.SetSourceRequest{
+		Decryption: expandMediaConnectEncryption(m["decryption"].([]interface{})),
+		Name:       aws.String(m["name"].(string)),
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		source.Description = aws.String(v)
+	}
+
+	if v, ok := m["entitlement_arn"].(string); ok && v != "" {
+		source.EntitlementArn = aws.String(v)
+	}
+
+	if v, ok := m["ingest_port"].(int); ok && v != 0 {
+		source.IngestPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_bitrate"].(int); ok && v != 0 {
+		source.MaxBitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_latency"].(int); ok && v != 0 {
+		source.MaxLatency = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["protocol"].(string); ok && v != "" {
+		source.Protocol = aws.String(v)
+	}
+
+	if v, ok := m["stream_id"].(string); ok && v != "" {
+		source.StreamId = aws.String(v)
+	}
+
+	if v, ok := m["whitelist_cidr"].(string); ok && v != "" {
+		source.WhitelistCidr = aws.String(v)
+	}
+
+	return source
+}
+
+func expandMediaConnectUpdateFlowSourceInput(l []interface{}) *mediaconnect.UpdateFlowSourceInput {
+	if len(l) == 0 || l[0] == nil {
+		return &mediaconnect.UpdateFlowSourceInput{}
+	}
+
+	m := l[0].(map[string]interface{})
+	source := expandMediaConnectSetSourceRequest(l)
+
+	return &mediaconnect.UpdateFlowSourceInput{
+		Decryption:     expandMediaConnectUpdateEncryption(m["decryption"].([]interface{})),
+		Description:    source.Description,
+		EntitlementArn: source.EntitlementArn,
+		IngestPort:     source.IngestPort,
+		MaxBitrate:     source.MaxBitrate,
+		MaxLatency:     source.MaxLatency,
+		Protocol:       source.Protocol,
+		SourceArn:      aws.String(m["source_arn"].(string)),
+		StreamId:       source.StreamId,
+		WhitelistCidr:  source.WhitelistCidr,
+	}
+}
+
+func flattenMediaConnectEncryption(encryption *mediaconnect.Encryption) []interface{} {
+	if encryption == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"algorithm":                      aws.StringValue(encryption.Algorithm),
+		"constant_initialization_vector": aws.StringValue(encryption.ConstantInitializationVector),
+		"device_id":                      aws.StringValue(encryption.DeviceId),
+		"key_type":                       aws.StringValue(encryption.KeyType),
+		"region":                         aws.StringValue(encryption.Region),
+		"resource_id":                    aws.StringValue(encryption.ResourceId),
+		"role_arn":                       aws.StringValue(encryption.RoleArn),
+		"secret_arn":                     aws.StringValue(encryption.SecretArn),
+		"url":                            aws.StringValue(encryption.Url),
+	}
+
+	return []interface{}{m}
+}
+
+// mediaConnectSortByName orders flattened entitlements or outputs to match
+// the configuration, since the API does not preserve their order.
+// Blocks that are not configured are placed last.
+func mediaConnectSortByName(l []interface{}, configured []interface{}) []interface{} {
+	order := make(map[string]int, len(configured))
+
+	for i, raw := range configured {
+		if m, ok := raw.(map[string]interface{}); ok {
+			order[m["name"].(string)] = i
+		}
+	}
+
+	position := func(raw interface{}) int {
+		if i, ok := order[raw.(map[string]interface{})["name"].(string)]; ok {
+			return i
+		}
+
+		return len(configured)
+	}
+
+	sort.SliceStable(l, func(i, j int) bool {
+		return position(l[i]) < position(l[j])
+	})
+
+	return l
+}
+
+func flattenMediaConnectEntitlements(entitlements []*mediaconnect.Entitlement, configured []interface{}) []interface{} {
+	l := make([]interface{}, 0, len(entitlements))
+
+	for _, entitlement := range entitlements {
+		if entitlement == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"description":     aws.StringValue(entitlement.Description),
+			"encryption":      flattenMediaConnectEncryption(entitlement.Encryption),
+			"entitlement_arn": aws.StringValue(entitlement.EntitlementArn),
+			"name":            aws.StringValue(entitlement.Name),
+			"subscribers":     flattenStringSet(entitlement.Subscribers),
+		})
+	}
+
+	return mediaConnectSortByName(l, configured)
+}
+
+func flattenMediaConnectOutputs(outputs []*mediaconnect.Output, configured []interface{}) []interface{} {
+	l := make([]interface{}, 0, len(outputs))
+
+	for _, output := range outputs {
+		if output == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"description": aws.StringValue(output.Description),
+			"destination": aws.StringValue(output.Destination),
+			"encryption":  flattenMediaConnectEncryption(output.Encryption),
+			"name":        aws.StringValue(output.Name),
+			"output_arn":  aws.StringValue(output.OutputArn),
+			"port":        int(aws.Int64Value(output.Port)),
+		}
+
+		if transport := output.Transport; transport != nil {
+			m["cidr_allow_list"] = flattenStringSet(transport.CidrAllowList)
+			m["max_latency"] = int(aws.Int64Value(transport.MaxLatency))
+			m["protocol"] = aws.StringValue(transport.Protocol)
+			m["remote_id"] = aws.StringValue(transport.RemoteId)
+			m["smoothing_latency"] = int(aws.Int64Value(transport.SmoothingLatency))
+			m["stream_id"] = aws.StringValue(transport.StreamId)
+		}
+
+		l = append(l, m)
+	}
+
+	return mediaConnectSortByName(l, configured)
+}
+
+func flattenMediaConnectSource(source *mediaconnect.Source) []interface{} {
+	if source == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"decryption":      flattenMediaConnectEncryption(source.Decryption),
+		"description":     aws.StringValue(source.Description),
+		"entitlement_arn": aws.StringValue(source.EntitlementArn),
+		"ingest_ip":       aws.StringValue(source.IngestIp),
+		"ingest_port":     int(aws.Int64Value(source.IngestPort)),
+		"name":            aws.StringValue(source.Name),
+		"source_arn":      aws.StringValue(source.SourceArn),
+		"whitelist_cidr":  aws.StringValue(source.WhitelistCidr),
+	}
+
+	if transport := source.Transport; transport != nil {
+		m["max_bitrate"] = int(aws.Int64Value(transport.MaxBitrate))
+		m["max_latency"] = int(aws.Int64Value(transport.MaxLatency))
+		m["protocol"] = aws.StringValue(transport.Protocol)
+		m["stream_id"] = aws.StringValue(transport.StreamId)
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaConnectFlow_basic(t *testing.T) {
+	var flow mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConnectFlowConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "mediaconnect", regexp.MustCompile(`flow:.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "availability_zone"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.name", "source1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.protocol", mediaconnect.ProtocolRtp),
+					resource.TestCheckResourceAttrSet(resourceName, "source.0.source_arn"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "start_flow", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", mediaconnect.StatusStandby),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConnectFlowConfig(rName, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "source.0.whitelist_cidr", "10.1.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConnectFlow_EntitlementsAndOutputs(t *testing.T) {
+	var flow mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConnectFlowConfigEntitlementsAndOutputs(rName, "description1", 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "description1"),
+					resource.TestCheckResourceAttrSet(resourceName, "entitlement.0.entitlement_arn"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.name", "entitlement1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.name", "output1"),
+					resource.TestCheckResourceAttrSet(resourceName, "output.0.output_arn"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "output.1.name", "output2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConnectFlowConfigEntitlementsAndOutputs(rName, "description2", 5010),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.0.description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "output.0.port", "5010"),
+				),
+			},
+			{
+				Config: testAccMediaConnectFlowConfig(rName, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "entitlement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaConnectFlow_Tags(t *testing.T) {
+	var flow mediaconnect.Flow
+	resourceName := "aws_mediaconnect_flow.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaConnect(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaConnectFlowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConnectFlowConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaConnectFlowConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaConnectFlowConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaConnectFlowExists(resourceName, &flow),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaConnectFlowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).mediaconnectconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mediaconnect_flow" {
+			continue
+		}
+
+		output, err := conn.DescribeFlow(&mediaconnect.DescribeFlowInput{
+			FlowArn: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, mediaconnect.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output.Flow != nil {
+			return fmt.Errorf("MediaConnect Flow (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaConnectFlowExists(resourceName string, flow *mediaconnect.Flow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaConnect Flow ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).mediaconnectconn
+
+		output, err := conn.DescribeFlow(&mediaconnect.DescribeFlowInput{
+			FlowArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Flow == nil {
+			return fmt.Errorf("MediaConnect Flow (%s) not found", rs.Primary.ID)
+		}
+
+		*flow = *output.Flow
+
+		return nil
+	}
+}
+
+func testAccPreCheckAWSMediaConnect(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).mediaconnectconn
+
+	input := &mediaconnect.ListFlowsInput{}
+
+	_, err := conn.ListFlows(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccMediaConnectFlowConfig(rName, whitelistCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    ingest_port    = 5000
+    name           = "source1"
+    protocol       = "rtp"
+    whitelist_cidr = %[2]q
+  }
+}
+`, rName, whitelistCidr)
+}
+
+func testAccMediaConnectFlowConfigEntitlementsAndOutputs(rName, entitlementDescription string, outputPort int) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    ingest_port    = 5000
+    name           = "source1"
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  entitlement {
+    description = %[2]q
+    name        = "entitlement1"
+    subscribers = ["${data.aws_caller_identity.current.account_id}"]
+  }
+
+  output {
+    destination = "10.0.0.1"
+    name        = "output1"
+    port        = %[3]d
+    protocol    = "rtp"
+  }
+
+  output {
+    destination = "10.0.0.2"
+    name        = "output2"
+    port        = 6000
+    protocol    = "rtp"
+  }
+}
+`, rName, entitlementDescription, outputPort)
+}
+
+func testAccMediaConnectFlowConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    ingest_port    = 5000
+    name           = "source1"
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMediaConnectFlowConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_mediaconnect_flow" "test" {
+  name = %[1]q
+
+  source {
+    ingest_port    = 5000
+    name           = "source1"
+    protocol       = "rtp"
+    whitelist_cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
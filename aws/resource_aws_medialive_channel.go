@@ -0,0 +1,665 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaLiveChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveChannelCreate,
+		Read:   resourceAwsMediaLiveChannelRead,
+		Update: resourceAwsMediaLiveChannelUpdate,
+		Delete: resourceAwsMediaLiveChannelDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.ChannelClassSinglePipeline,
+					medialive.ChannelClassStandard,
+				}, false),
+			},
+			"destinations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressAwsSdkSettingsJsonDiffs(func() interface{} { return &medialive.EncoderSettings{} }),
+			},
+			"input_attachments": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputCodecAvc,
+								medialive.InputCodecHevc,
+								medialive.InputCodecMpeg2,
+							}, false),
+						},
+						"maximum_bitrate": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputMaximumBitrateMax10Mbps,
+								medialive.InputMaximumBitrateMax20Mbps,
+								medialive.InputMaximumBitrateMax50Mbps,
+							}, false),
+						},
+						"resolution": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								medialive.InputResolutionHd,
+								medialive.InputResolutionSd,
+								medialive.InputResolutionUhd,
+							}, false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  medialive.LogLevelDisabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.LogLevelDebug,
+					medialive.LogLevelDisabled,
+					medialive.LogLevelError,
+					medialive.LogLevelInfo,
+					medialive.LogLevelWarning,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_channel": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	name := d.Get("name").(string)
+
+	encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings_json").(string))
+	if err != nil {
+		return err
+	}
+
+	input := &medialive.CreateChannelInput{
+		ChannelClass:       aws.String(d.Get("channel_class").(string)),
+		Destinations:       expandMediaLiveOutputDestinations(d.Get("destinations").([]interface{})),
+		EncoderSettings:    encoderSettings,
+		InputAttachments:   expandMediaLiveInputAttachments(d.Get("input_attachments").([]interface{})),
+		InputSpecification: expandMediaLiveInputSpecification(d.Get("input_specification").([]interface{})),
+		LogLevel:           aws.String(d.Get("log_level").(string)),
+		Name:               aws.String(name),
+		RequestId:          aws.String(resource.UniqueId()),
+		Tags:               keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MedialiveTags(),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if err := waitForMediaLiveChannelState(conn, d.Id(), []string{medialive.ChannelStateCreating}, medialive.ChannelStateIdle, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) creation: %s", d.Id(), err)
+	}
+
+	if d.Get("start_channel").(bool) {
+		if err := startMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	output, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	state := aws.StringValue(output.State)
+
+	if state == medialive.ChannelStateDeleted {
+		log.Printf("[WARN] MediaLive Channel (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("channel_class", output.ChannelClass)
+
+	if err := d.Set("destinations", flattenMediaLiveOutputDestinations(output.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %s", err)
+	}
+
+	encoderSettingsJSON, err := flattenAwsSdkSettingsJsonWithConfigured(output.EncoderSettings, d.Get("encoder_settings_json").(string), func() interface{} { return &medialive.EncoderSettings{} })
+	if err != nil {
+		return fmt.Errorf("error flattening MediaLive Channel (%s) encoder settings: %s", d.Id(), err)
+	}
+
+	d.Set("encoder_settings_json", encoderSettingsJSON)
+
+	if err := d.Set("input_attachments", flattenMediaLiveInputAttachments(output.InputAttachments)); err != nil {
+		return fmt.Errorf("error setting input_attachments: %s", err)
+	}
+
+	if err := d.Set("input_specification", flattenMediaLiveInputSpecification(output.InputSpecification)); err != nil {
+		return fmt.Errorf("error setting input_specification: %s", err)
+	}
+
+	d.Set("log_level", output.LogLevel)
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("start_channel", state == medialive.ChannelStateRunning || state == medialive.ChannelStateStarting)
+
+	if err := setTagsAll(d, meta, keyvaluetags.MedialiveKeyValueTags(output.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("destinations") || d.HasChange("encoder_settings_json") || d.HasChange("input_attachments") ||
+		d.HasChange("input_specification") || d.HasChange("log_level") || d.HasChange("name") || d.HasChange("role_arn") {
+		// Channels can only be updated while idle.
+		o, _ := d.GetChange("start_channel")
+
+		if o.(bool) {
+			if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		encoderSettings, err := expandMediaLiveEncoderSettings(d.Get("encoder_settings_json").(string))
+		if err != nil {
+			return err
+		}
+
+		input := &medialive.UpdateChannelInput{
+			ChannelId:          aws.String(d.Id()),
+			Destinations:       expandMediaLiveOutputDestinations(d.Get("destinations").([]interface{})),
+			EncoderSettings:    encoderSettings,
+			InputAttachments:   expandMediaLiveInputAttachments(d.Get("input_attachments").([]interface{})),
+			InputSpecification: expandMediaLiveInputSpecification(d.Get("input_specification").([]interface{})),
+			LogLevel:           aws.String(d.Get("log_level").(string)),
+			Name:               aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		if _, err := conn.UpdateChannel(input); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMediaLiveChannelState(conn, d.Id(), []string{medialive.ChannelStateUpdating}, medialive.ChannelStateIdle, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("start_channel").(bool) {
+			if err := startMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("start_channel") {
+		if d.Get("start_channel").(bool) {
+			if err := startMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		} else {
+			if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveChannelRead(d, meta)
+}
+
+func resourceAwsMediaLiveChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.Get("start_channel").(bool) {
+		if err := stopMediaLiveChannel(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForMediaLiveChannelDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func startMediaLiveChannel(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting MediaLive Channel: %s", id)
+	_, err := conn.StartChannel(&medialive.StartChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting MediaLive Channel (%s): %s", id, err)
+	}
+
+	if err := waitForMediaLiveChannelState(conn, id, []string{medialive.ChannelStateStarting}, medialive.ChannelStateRunning, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to start: %s", id, err)
+	}
+
+	return nil
+}
+
+func stopMediaLiveChannel(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Stopping MediaLive Channel: %s", id)
+	_, err := conn.StopChannel(&medialive.StopChannelInput{
+		ChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping MediaLive Channel (%s): %s", id, err)
+	}
+
+	if err := waitForMediaLiveChannelState(conn, id, []string{medialive.ChannelStateStopping}, medialive.ChannelStateIdle, timeout); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) to stop: %s", id, err)
+	}
+
+	return nil
+}
+
+func mediaLiveChannelStateRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(id),
+		})
+
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(output.State)
+
+		if state == medialive.ChannelStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, state, nil
+	}
+}
+
+func waitForMediaLiveChannelState(conn *medialive.MediaLive, id string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    mediaLiveChannelStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForMediaLiveChannelDeletion(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.ChannelStateDeleting,
+			medialive.ChannelStateIdle,
+		},
+		Target:     []string{},
+		Refresh:    mediaLiveChannelStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandMediaLiveEncoderSettings(rawSettings string) (*medialive.EncoderSettings, error) {
+	settings := &medialive.EncoderSettings{}
+
+	if err := json.Unmarshal([]byte(rawSettings), settings); err != nil {
+		return nil, fmt.Errorf("error decoding MediaLive Channel encoder settings JSON: %s", err)
+	}
+
+	return settings, nil
+}
+
+func expandMediaLiveOutputDestinations(l []interface{}) []*medialive.OutputDestination {
+	var destinations []*medialive.OutputDestination
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		destination := &medialive.OutputDestination{
+			Id: aws.String(m["id"].(string)),
+		}
+
+		if v, ok := m["media_package_settings"].([]interface{}); ok && len(v) > 0 {
+			for _, raw := range v {
+				mps, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				destination.MediaPackageSettings = append(destination.MediaPackageSettings, &medialive.MediaPackageOutputDestinationSettings{
+					ChannelId: aws.String(mps["channel_id"].(string)),
+				})
+			}
+		}
+
+		if v, ok := m["settings"].([]interface{}); ok && len(v) > 0 {
+			for _, raw := range v {
+				ods, ok := raw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				settings := &medialive.OutputDestinationSettings{}
+
+				if v, ok := ods["password_param"].(string); ok && v != "" {
+					settings.PasswordParam = aws.String(v)
+				}
+
+				if v, ok := ods["stream_name"].(string); ok && v != "" {
+					settings.StreamName = aws.String(v)
+				}
+
+				if v, ok := ods["url"].(string); ok && v != "" {
+					settings.Url = aws.String(v)
+				}
+
+				if v, ok := ods["username"].(string); ok && v != "" {
+					settings.Username = aws.String(v)
+				}
+
+				destination.Settings = append(destination.Settings, settings)
+			}
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations
+}
+
+func expandMediaLiveInputAttachments(l []interface{}) []*medialive.InputAttachment {
+	var attachments []*medialive.InputAttachment
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attachments = append(attachments, &medialive.InputAttachment{
+			InputAttachmentName: aws.String(m["input_attachment_name"].(string)),
+			InputId:             aws.String(m["input_id"].(string)),
+		})
+	}
+
+	return attachments
+}
+
+func expandMediaLiveInputSpecification(l []interface{}) *medialive.InputSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(m["codec"].(string)),
+		MaximumBitrate: aws.String(m["maximum_bitrate"].(string)),
+		Resolution:     aws.String(m["resolution"].(string)),
+	}
+}
+
+func flattenMediaLiveOutputDestinations(destinations []*medialive.OutputDestination) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		if destination == nil {
+			continue
+		}
+
+		mediaPackageSettings := make([]interface{}, 0, len(destination.MediaPackageSettings))
+
+		for _, v := range destination.MediaPackageSettings {
+			if v == nil {
+				continue
+			}
+
+			mediaPackageSettings = append(mediaPackageSettings, map[string]interface{}{
+				"channel_id": aws.StringValue(v.ChannelId),
+			})
+		}
+
+		settings := make([]interface{}, 0, len(destination.Settings))
+
+		for _, v := range destination.Settings {
+			if v == nil {
+				continue
+			}
+
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(v.PasswordParam),
+				"stream_name":    aws.StringValue(v.StreamName),
+				"url":            aws.StringValue(v.Url),
+				"username":       aws.StringValue(v.Username),
+			})
+		}
+
+		l = append(l, map[string]interface{}{
+			"id":                     aws.StringValue(destination.Id),
+			"media_package_settings": mediaPackageSettings,
+			"settings":               settings,
+		})
+	}
+
+	return l
+}
+
+func flattenMediaLiveInputAttachments(attachments []*medialive.InputAttachment) []interface{} {
+	l := make([]interface{}, 0, len(attachments))
+
+	for _, attachment := range attachments {
+		if attachment == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"input_attachment_name": aws.StringValue(attachment.InputAttachmentName),
+			"input_id":              aws.StringValue(attachment.InputId),
+		})
+	}
+
+	return l
+}
+
+func flattenMediaLiveInputSpecification(specification *medialive.InputSpecification) []interface{} {
+	if specification == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"codec":           aws.StringValue(specification.Codec),
+		"maximum_bitrate": aws.StringValue(specification.MaximumBitrate),
+		"resolution":      aws.StringValue(specification.Resolution),
+	}
+
+	return []interface{}{m}
+}
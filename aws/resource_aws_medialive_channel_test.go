@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaLiveChannel_basic(t *testing.T) {
+	var channel medialive.DescribeChannelOutput
+	resourceName := "aws_medialive_channel.test"
+	inputResourceName := "aws_medialive_input.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveChannelConfig(rName, medialive.LogLevelDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", medialive.ChannelClassSinglePipeline),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_attachments.0.input_id", inputResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_level", medialive.LogLevelDisabled),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "start_channel", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encoder_settings_json"},
+			},
+			{
+				Config: testAccMediaLiveChannelConfig(rName, medialive.LogLevelError),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveChannelExists(resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "log_level", medialive.LogLevelError),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveChannelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		output, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.State) == medialive.ChannelStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Channel (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveChannelExists(resourceName string, channel *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := conn.DescribeChannel(&medialive.DescribeChannelInput{
+			ChannelId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*channel = *output
+
+		return nil
+	}
+}
+
+func testAccMediaLiveChannelConfig(rName, logLevel string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "medialive.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:GetObject",
+        "s3:DeleteObject"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = "https://example.com/live/stream.m3u8"
+  }
+}
+
+resource "aws_medialive_channel" "test" {
+  channel_class = "SINGLE_PIPELINE"
+  log_level     = %[2]q
+  name          = %[1]q
+  role_arn      = "${aws_iam_role.test.arn}"
+
+  destinations {
+    id = "destination1"
+
+    settings {
+      url = "s3ssl://${aws_s3_bucket.test.id}/output/test"
+    }
+  }
+
+  input_attachments {
+    input_attachment_name = "input1"
+    input_id              = "${aws_medialive_input.test.id}"
+  }
+
+  input_specification {
+    codec           = "AVC"
+    maximum_bitrate = "MAX_10_MBPS"
+    resolution      = "HD"
+  }
+
+  encoder_settings_json = <<EOF
+{
+  "AudioDescriptions": [
+    {
+      "AudioSelectorName": "default",
+      "Name": "audio_1"
+    }
+  ],
+  "OutputGroups": [
+    {
+      "OutputGroupSettings": {
+        "ArchiveGroupSettings": {
+          "Destination": {
+            "DestinationRefId": "destination1"
+          }
+        }
+      },
+      "Outputs": [
+        {
+          "AudioDescriptionNames": ["audio_1"],
+          "OutputName": "output1",
+          "OutputSettings": {
+            "ArchiveOutputSettings": {
+              "ContainerSettings": {
+                "M2tsSettings": {}
+              },
+              "Extension": "m2ts",
+              "NameModifier": "_1"
+            }
+          },
+          "VideoDescriptionName": "video_1"
+        }
+      ]
+    }
+  ],
+  "TimecodeConfig": {
+    "Source": "EMBEDDED"
+  },
+  "VideoDescriptions": [
+    {
+      "Name": "video_1"
+    }
+  ]
+}
+EOF
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, logLevel)
+}
@@ -0,0 +1,560 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputCreate,
+		Read:   resourceAwsMediaLiveInputRead,
+		Update: resourceAwsMediaLiveInputUpdate,
+		Delete: resourceAwsMediaLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attached_channels": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"destinations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stream_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"input_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"input_source_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_connect_flows": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flow_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"password_param": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					medialive.InputTypeMediaconnect,
+					medialive.InputTypeMp4File,
+					medialive.InputTypeRtmpPull,
+					medialive.InputTypeRtmpPush,
+					medialive.InputTypeRtpPush,
+					medialive.InputTypeUdpPush,
+					medialive.InputTypeUrlPull,
+				}, false),
+			},
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	name := d.Get("name").(string)
+
+	input := &medialive.CreateInputInput{
+		Name:      aws.String(name),
+		RequestId: aws.String(resource.UniqueId()),
+		Tags:      keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MedialiveTags(),
+		Type:      aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("destinations"); ok {
+		input.Destinations = expandMediaLiveInputDestinationRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("input_security_groups"); ok && v.(*schema.Set).Len() > 0 {
+		input.InputSecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("media_connect_flows"); ok {
+		input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sources"); ok {
+		input.Sources = expandMediaLiveInputSourceRequests(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc"); ok {
+		input.Vpc = expandMediaLiveInputVpcRequest(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input: %s", input)
+	output, err := conn.CreateInput(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Input.Id))
+
+	if err := waitForMediaLiveInputCreation(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	output, err := conn.DescribeInput(&medialive.DescribeInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Input (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == medialive.InputStateDeleted {
+		log.Printf("[WARN] MediaLive Input (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+
+	if err := d.Set("attached_channels", flattenStringSet(output.AttachedChannels)); err != nil {
+		return fmt.Errorf("error setting attached_channels: %s", err)
+	}
+
+	if err := d.Set("destinations", flattenMediaLiveInputDestinations(output.Destinations, aws.StringValue(output.Type))); err != nil {
+		return fmt.Errorf("error setting destinations: %s", err)
+	}
+
+	d.Set("input_class", output.InputClass)
+
+	if err := d.Set("input_security_groups", flattenStringSet(output.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting input_security_groups: %s", err)
+	}
+
+	d.Set("input_source_type", output.InputSourceType)
+
+	if err := d.Set("media_connect_flows", flattenMediaLiveMediaConnectFlows(output.MediaConnectFlows)); err != nil {
+		return fmt.Errorf("error setting media_connect_flows: %s", err)
+	}
+
+	d.Set("name", output.Name)
+	d.Set("role_arn", output.RoleArn)
+
+	if err := d.Set("sources", flattenMediaLiveInputSources(output.Sources)); err != nil {
+		return fmt.Errorf("error setting sources: %s", err)
+	}
+
+	d.Set("type", output.Type)
+
+	// The VPC configuration is not returned by the API and is kept as configured.
+
+	if err := setTagsAll(d, meta, keyvaluetags.MedialiveKeyValueTags(output.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("destinations") || d.HasChange("input_security_groups") || d.HasChange("media_connect_flows") ||
+		d.HasChange("name") || d.HasChange("role_arn") || d.HasChange("sources") {
+		input := &medialive.UpdateInputInput{
+			InputId: aws.String(d.Id()),
+			Name:    aws.String(d.Get("name").(string)),
+		}
+
+		if d.HasChange("destinations") {
+			input.Destinations = expandMediaLiveInputDestinationRequests(d.Get("destinations").([]interface{}))
+		}
+
+		if d.HasChange("input_security_groups") {
+			input.InputSecurityGroups = expandStringSet(d.Get("input_security_groups").(*schema.Set))
+		}
+
+		if d.HasChange("media_connect_flows") {
+			input.MediaConnectFlows = expandMediaLiveMediaConnectFlowRequests(d.Get("media_connect_flows").([]interface{}))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		if d.HasChange("sources") {
+			input.Sources = expandMediaLiveInputSourceRequests(d.Get("sources").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input: %s", input)
+		if _, err := conn.UpdateInput(input); err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input: %s", d.Id())
+	_, err := conn.DeleteInput(&medialive.DeleteInputInput{
+		InputId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForMediaLiveInputDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Input (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveInputStateRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(id),
+		})
+
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		state := aws.StringValue(output.State)
+
+		if state == medialive.InputStateDeleted {
+			return nil, "", nil
+		}
+
+		return output, state, nil
+	}
+}
+
+func waitForMediaLiveInputCreation(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputStateCreating},
+		Target: []string{
+			medialive.InputStateAttached,
+			medialive.InputStateDetached,
+		},
+		Refresh:    mediaLiveInputStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForMediaLiveInputDeletion(conn *medialive.MediaLive, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			medialive.InputStateDeleting,
+			medialive.InputStateDetached,
+		},
+		Target:     []string{},
+		Refresh:    mediaLiveInputStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandMediaLiveInputDestinationRequests(l []interface{}) []*medialive.InputDestinationRequest {
+	var destinations []*medialive.InputDestinationRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		destination := &medialive.InputDestinationRequest{}
+
+		if v, ok := m["stream_name"].(string); ok && v != "" {
+			destination.StreamName = aws.String(v)
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations
+}
+
+func expandMediaLiveInputSourceRequests(l []interface{}) []*medialive.InputSourceRequest {
+	var sources []*medialive.InputSourceRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		source := &medialive.InputSourceRequest{
+			Url: aws.String(m["url"].(string)),
+		}
+
+		if v, ok := m["password_param"].(string); ok && v != "" {
+			source.PasswordParam = aws.String(v)
+		}
+
+		if v, ok := m["username"].(string); ok && v != "" {
+			source.Username = aws.String(v)
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources
+}
+
+func expandMediaLiveMediaConnectFlowRequests(l []interface{}) []*medialive.MediaConnectFlowRequest {
+	var flows []*medialive.MediaConnectFlowRequest
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		flows = append(flows, &medialive.MediaConnectFlowRequest{
+			FlowArn: aws.String(m["flow_arn"].(string)),
+		})
+	}
+
+	return flows
+}
+
+func expandMediaLiveInputVpcRequest(l []interface{}) *medialive.InputVpcRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	vpc := &medialive.InputVpcRequest{
+		SubnetIds: expandStringSet(m["subnet_ids"].(*schema.Set)),
+	}
+
+	if v, ok := m["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		vpc.SecurityGroupIds = expandStringSet(v)
+	}
+
+	return vpc
+}
+
+func flattenMediaLiveInputDestinations(destinations []*medialive.InputDestination, inputType string) []interface{} {
+	l := make([]interface{}, 0, len(destinations))
+
+	for _, destination := range destinations {
+		if destination == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"ip":          aws.StringValue(destination.Ip),
+			"port":        aws.StringValue(destination.Port),
+			"stream_name": "",
+			"url":         aws.StringValue(destination.Url),
+		}
+
+		// The stream name of RTMP push inputs is the path of the destination URL.
+		if inputType == medialive.InputTypeRtmpPush {
+			if u, err := url.Parse(aws.StringValue(destination.Url)); err == nil {
+				m["stream_name"] = strings.TrimPrefix(u.Path, "/")
+			}
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
+func flattenMediaLiveInputSources(sources []*medialive.InputSource) []interface{} {
+	l := make([]interface{}, 0, len(sources))
+
+	for _, source := range sources {
+		if source == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"password_param": aws.StringValue(source.PasswordParam),
+			"url":            aws.StringValue(source.Url),
+			"username":       aws.StringValue(source.Username),
+		})
+	}
+
+	return l
+}
+
+func flattenMediaLiveMediaConnectFlows(flows []*medialive.MediaConnectFlow) []interface{} {
+	l := make([]interface{}, 0, len(flows))
+
+	for _, flow := range flows {
+		if flow == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"flow_arn": aws.StringValue(flow.FlowArn),
+		})
+	}
+
+	return l
+}
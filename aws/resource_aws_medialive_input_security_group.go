@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsMediaLiveInputSecurityGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsMediaLiveInputSecurityGroupCreate,
+		Read:   resourceAwsMediaLiveInputSecurityGroupRead,
+		Update: resourceAwsMediaLiveInputSecurityGroupUpdate,
+		Delete: resourceAwsMediaLiveInputSecurityGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inputs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"whitelist_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsMediaLiveInputSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	input := &medialive.CreateInputSecurityGroupInput{
+		Tags:           keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().MedialiveTags(),
+		WhitelistRules: expandMediaLiveInputWhitelistRules(d.Get("whitelist_rule").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Input Security Group: %s", input)
+	output, err := conn.CreateInputSecurityGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Input Security Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.SecurityGroup.Id))
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	output, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.State) == medialive.InputSecurityGroupStateDeleted {
+		log.Printf("[WARN] MediaLive Input Security Group (%s) deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+
+	if err := d.Set("inputs", flattenStringSet(output.Inputs)); err != nil {
+		return fmt.Errorf("error setting inputs: %s", err)
+	}
+
+	if err := d.Set("whitelist_rule", flattenMediaLiveInputWhitelistRules(output.WhitelistRules)); err != nil {
+		return fmt.Errorf("error setting whitelist_rule: %s", err)
+	}
+
+	if err := setTagsAll(d, meta, keyvaluetags.MedialiveKeyValueTags(output.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsMediaLiveInputSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	if d.HasChange("whitelist_rule") {
+		input := &medialive.UpdateInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(d.Id()),
+			WhitelistRules:       expandMediaLiveInputWhitelistRules(d.Get("whitelist_rule").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Input Security Group: %s", input)
+		if _, err := conn.UpdateInputSecurityGroup(input); err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s): %s", d.Id(), err)
+		}
+
+		if err := waitForMediaLiveInputSecurityGroupUpdate(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Input Security Group (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.MedialiveUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Input Security Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsMediaLiveInputSecurityGroupRead(d, meta)
+}
+
+func resourceAwsMediaLiveInputSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).medialiveconn
+
+	log.Printf("[DEBUG] Deleting MediaLive Input Security Group: %s", d.Id())
+	_, err := conn.DeleteInputSecurityGroup(&medialive.DeleteInputSecurityGroupInput{
+		InputSecurityGroupId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Input Security Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func mediaLiveInputSecurityGroupStateRefreshFunc(conn *medialive.MediaLive, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeInputSecurityGroup(&medialive.DescribeInputSecurityGroupInput{
+			InputSecurityGroupId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+func waitForMediaLiveInputSecurityGroupUpdate(conn *medialive.MediaLive, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.InputSecurityGroupStateUpdating},
+		Target: []string{
+			medialive.InputSecurityGroupStateIdle,
+			medialive.InputSecurityGroupStateInUse,
+		},
+		Refresh:    mediaLiveInputSecurityGroupStateRefreshFunc(conn, id),
+		Timeout:    5 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandMediaLiveInputWhitelistRules(l []interface{}) []*medialive.InputWhitelistRuleCidr {
+	rules := make([]*medialive.InputWhitelistRuleCidr, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rules = append(rules, &medialive.InputWhitelistRuleCidr{
+			Cidr: aws.String(m["cidr"].(string)),
+		})
+	}
+
+	return rules
+}
+
+func flattenMediaLiveInputWhitelistRules(rules []*medialive.InputWhitelistRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"cidr": aws.StringValue(rule.Cidr),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSMediaLiveInput_basic(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputConfigRtmpPush(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`input:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attached_channels.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.0.stream_name", "live/stream1"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.1.stream_name", "live/stream2"),
+					resource.TestCheckResourceAttr(resourceName, "input_security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeRtmpPush),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveInputConfigRtmpPush(rName, fmt.Sprintf("%s-updated", rName)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_UrlPull(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputConfigUrlPull(rName, "https://example.com/live/stream1.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.url", "https://example.com/live/stream1.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "type", medialive.InputTypeUrlPull),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveInputConfigUrlPull(rName, "https://example.com/live/stream2.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.url", "https://example.com/live/stream2.m3u8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSMediaLiveInput_Tags(t *testing.T) {
+	var input medialive.DescribeInputOutput
+	resourceName := "aws_medialive_input.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSMediaLive(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsMediaLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveInputConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaLiveInputConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMediaLiveInputConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsMediaLiveInputExists(resourceName, &input),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsMediaLiveInputDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_input" {
+			continue
+		}
+
+		output, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, medialive.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.State) == medialive.InputStateDeleted {
+			continue
+		}
+
+		return fmt.Errorf("MediaLive Input (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsMediaLiveInputExists(resourceName string, input *medialive.DescribeInputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Input ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).medialiveconn
+
+		output, err := conn.DescribeInput(&medialive.DescribeInputInput{
+			InputId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*input = *output
+
+		return nil
+	}
+}
+
+func testAccMediaLiveInputConfigRtmpPush(rName, name string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input_security_group" "test" {
+  whitelist_rule {
+    cidr = "10.0.0.0/16"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_medialive_input" "test" {
+  input_security_groups = ["${aws_medialive_input_security_group.test.id}"]
+  name                  = %[2]q
+  type                  = "RTMP_PUSH"
+
+  destinations {
+    stream_name = "live/stream1"
+  }
+
+  destinations {
+    stream_name = "live/stream2"
+  }
+}
+`, rName, name)
+}
+
+func testAccMediaLiveInputConfigUrlPull(rName, url string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = %[2]q
+  }
+}
+`, rName, url)
+}
+
+func testAccMediaLiveInputConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = "https://example.com/live/stream1.m3u8"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMediaLiveInputConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_medialive_input" "test" {
+  name = %[1]q
+  type = "URL_PULL"
+
+  sources {
+    url = "https://example.com/live/stream1.m3u8"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
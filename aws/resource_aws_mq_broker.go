@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mitchellh/copystructure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMqBroker() *schema.Resource {
@@ -22,6 +23,8 @@ func resourceAwsMqBroker() *schema.Resource {
 		Update: resourceAwsMqBrokerUpdate,
 		Delete: resourceAwsMqBrokerDelete,
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"apply_immediately": {
 				Type:     schema.TypeBool,
@@ -203,7 +206,8 @@ func resourceAwsMqBroker() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -238,8 +242,8 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("subnet_ids"); ok {
 		input.SubnetIds = expandStringList(v.(*schema.Set).List())
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().MqTags()
 	}
 
 	log.Printf("[INFO] Creating MQ Broker: %s", input)
@@ -279,6 +283,7 @@ func resourceAwsMqBrokerCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	log.Printf("[INFO] Reading MQ Broker: %s", d.Id())
 	out, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
@@ -346,7 +351,17 @@ func resourceAwsMqBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return getTagsMQ(conn, d, aws.StringValue(out.BrokerArn))
+	tags, err := keyvaluetags.MqListTags(conn, aws.StringValue(out.BrokerArn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MQ Broker (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
 
 func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -404,8 +419,12 @@ func resourceAwsMqBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if tagErr := setTagsMQ(conn, d, d.Get("arn").(string)); tagErr != nil {
-		return fmt.Errorf("error setting mq broker tags: %s", tagErr)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if tagErr := keyvaluetags.MqUpdateTags(conn, d.Get("arn").(string), o, n); tagErr != nil {
+			return fmt.Errorf("error setting mq broker tags: %s", tagErr)
+		}
 	}
 
 	return nil
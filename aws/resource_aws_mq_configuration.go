@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsMqConfiguration() *schema.Resource {
@@ -20,6 +21,10 @@ func resourceAwsMqConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := setTagsDiff(diff, v); err != nil {
+				return err
+			}
+
 			if diff.HasChange("description") {
 				return diff.SetNewComputed("latest_revision")
 			}
@@ -67,7 +72,8 @@ func resourceAwsMqConfiguration() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -81,8 +87,8 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 		Name:          aws.String(d.Get("name").(string)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().MqTags()
 	}
 
 	log.Printf("[INFO] Creating MQ Configuration: %s", input)
@@ -99,6 +105,7 @@ func resourceAwsMqConfigurationCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).mqconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	log.Printf("[INFO] Reading MQ Configuration %s", d.Id())
 	out, err := conn.DescribeConfiguration(&mq.DescribeConfigurationInput{
@@ -135,7 +142,17 @@ func resourceAwsMqConfigurationRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("data", string(b))
 
-	return getTagsMQ(conn, d, aws.StringValue(out.Arn))
+	tags, err := keyvaluetags.MqListTags(conn, aws.StringValue(out.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for MQ Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
 }
 
 func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -158,8 +175,12 @@ func resourceAwsMqConfigurationUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if tagErr := setTagsMQ(conn, d, d.Get("arn").(string)); tagErr != nil {
-		return fmt.Errorf("error setting mq configuration tags: %s", err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if tagErr := keyvaluetags.MqUpdateTags(conn, d.Get("arn").(string), o, n); tagErr != nil {
+			return fmt.Errorf("error setting mq configuration tags: %s", err)
+		}
 	}
 
 	return resourceAwsMqConfigurationRead(d, meta)
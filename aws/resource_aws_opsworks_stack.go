@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsOpsworksStack() *schema.Resource {
@@ -27,6 +28,8 @@ func resourceAwsOpsworksStack() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"agent_version": {
 				Type:     schema.TypeString,
@@ -170,7 +173,8 @@ func resourceAwsOpsworksStack() *schema.Resource {
 				Default:  "Layer_Dependent",
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 
 			"use_custom_cookbooks": {
 				Type:     schema.TypeBool,
@@ -363,6 +367,16 @@ func resourceAwsOpsworksStackRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	resourceAwsOpsworksSetStackCustomCookbooksSource(d, stack.CustomCookbooksSource)
 
+	tags, err := keyvaluetags.OpsworksListTags(client, aws.StringValue(stack.Arn))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for OpsWorks Stack (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
 	return nil
 }
 
@@ -533,8 +547,12 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
 	}
 
-	if tagErr := setTagsOpsworks(client, d, arn.String()); tagErr != nil {
-		return tagErr
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if tagErr := keyvaluetags.OpsworksUpdateTags(client, arn.String(), o, n); tagErr != nil {
+			return tagErr
+		}
 	}
 
 	req.ChefConfiguration = &opsworks.ChefConfiguration{
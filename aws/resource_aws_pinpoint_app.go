@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsPinpointApp() *schema.Resource {
@@ -21,6 +22,8 @@ func resourceAwsPinpointApp() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -132,7 +135,8 @@ func resourceAwsPinpointApp() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -158,8 +162,8 @@ func resourceAwsPinpointAppCreate(d *schema.ResourceData, meta interface{}) erro
 		},
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		req.CreateApplicationRequest.Tags = tagsFromMapPinPointApp(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		req.CreateApplicationRequest.Tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().PinpointTags()
 	}
 
 	output, err := pinpointconn.CreateApp(req)
@@ -204,8 +208,12 @@ func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := setTagsPinPointApp(conn, d); err != nil {
-		return fmt.Errorf("error updating PinPoint Application (%s) tags: %s", d.Id(), err)
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.PinpointUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating PinPoint Application (%s) tags: %s", d.Id(), err)
+		}
 	}
 
 	return resourceAwsPinpointAppRead(d, meta)
@@ -213,6 +221,7 @@ func resourceAwsPinpointAppUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).pinpointconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	log.Printf("[INFO] Reading Pinpoint App Attributes for %s", d.Id())
 
@@ -256,7 +265,13 @@ func resourceAwsPinpointAppRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting quiet_time: %s", err)
 	}
 
-	if err := getTagsPinPointApp(conn, d); err != nil {
+	tags, err := keyvaluetags.PinpointListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Pinpoint App (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsRamResourceShare() *schema.Resource {
@@ -28,6 +29,8 @@ func resourceAwsRamResourceShare() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -45,7 +48,8 @@ func resourceAwsRamResourceShare() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -58,8 +62,8 @@ func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{})
 		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		tags := tagsFromMapRAM(v.(map[string]interface{}))
+	if v, ok := d.GetOk("tags_all"); ok {
+		tags := keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().RamTags()
 		request.Tags = tags
 	}
 
@@ -88,6 +92,7 @@ func resourceAwsRamResourceShareCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ramconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 
 	request := &ram.GetResourceSharesInput{
 		ResourceShareArns: []*string{aws.String(d.Id())},
@@ -122,7 +127,7 @@ func resourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("name", resourceShare.Name)
 	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
 
-	if err := d.Set("tags", tagsToMapRAM(resourceShare.Tags)); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.RamKeyValueTags(resourceShare.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("Error setting tags: %s", err)
 	}
 
@@ -156,35 +161,15 @@ func resourceAwsRamResourceShareUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("allow_external_principals")
 	}
 
-	if d.HasChange("tags") {
-		// Reset all tags to empty set
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		c, r := diffTagsRAM(tagsFromMapRAM(o), tagsFromMapRAM(n))
-
-		if len(r) > 0 {
-			_, err := conn.UntagResource(&ram.UntagResourceInput{
-				ResourceShareArn: aws.String(d.Id()),
-				TagKeys:          tagKeysRam(r),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting RAM resource share tags: %s", err)
-			}
-		}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-		if len(c) > 0 {
-			input := &ram.TagResourceInput{
-				ResourceShareArn: aws.String(d.Id()),
-				Tags:             c,
-			}
-			_, err := conn.TagResource(input)
-			if err != nil {
-				return fmt.Errorf("Error updating RAM resource share tags: %s", err)
-			}
+		if err := keyvaluetags.RamUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating RAM Resource Share (%s) tags: %s", d.Id(), err)
 		}
 
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
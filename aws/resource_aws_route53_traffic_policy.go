@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsRoute53TrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyCreate,
+		Read:   resourceAwsRoute53TrafficPolicyRead,
+		Update: resourceAwsRoute53TrafficPolicyUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsRoute53TrafficPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"document": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 102400),
+					validation.ValidateJsonString,
+				),
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAwsRoute53TrafficPolicyCustomizeDiff marks the version as computed
+// when the document changes, as updating the document creates a new version.
+func resourceAwsRoute53TrafficPolicyCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() != "" && diff.HasChange("document") {
+		return diff.SetNewComputed("version")
+	}
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+	name := d.Get("name").(string)
+
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy: %s", input)
+	output, err := conn.CreateTrafficPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route53 Traffic Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicy.Id))
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	trafficPolicy, err := getLatestRoute53TrafficPolicyVersion(conn, d.Id())
+
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+		log.Printf("[WARN] Route53 Traffic Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route53 Traffic Policy (%s): %s", d.Id(), err)
+	}
+
+	if trafficPolicy == nil {
+		log.Printf("[WARN] Route53 Traffic Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("comment", trafficPolicy.Comment)
+	d.Set("document", trafficPolicy.Document)
+	d.Set("name", trafficPolicy.Name)
+	d.Set("type", trafficPolicy.Type)
+	d.Set("version", trafficPolicy.Version)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	// Traffic policy documents are immutable, a changed document is published as a new version.
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 Traffic Policy version: %s", input)
+		if _, err := conn.CreateTrafficPolicyVersion(input); err != nil {
+			return fmt.Errorf("error creating Route53 Traffic Policy (%s) version: %s", d.Id(), err)
+		}
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 Traffic Policy comment: %s", input)
+		if _, err := conn.UpdateTrafficPolicyComment(input); err != nil {
+			return fmt.Errorf("error updating Route53 Traffic Policy (%s) comment: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	versions, err := listRoute53TrafficPolicyVersions(conn, d.Id())
+
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route53 Traffic Policy (%s) versions: %s", d.Id(), err)
+	}
+
+	// A traffic policy is deleted once all of its versions have been deleted.
+	for _, version := range versions {
+		input := &route53.DeleteTrafficPolicyInput{
+			Id:      aws.String(d.Id()),
+			Version: version.Version,
+		}
+
+		log.Printf("[DEBUG] Deleting Route53 Traffic Policy version: %s", input)
+		_, err := conn.DeleteTrafficPolicy(input)
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Route53 Traffic Policy (%s) version %d: %s", d.Id(), aws.Int64Value(version.Version), err)
+		}
+	}
+
+	return nil
+}
+
+func listRoute53TrafficPolicyVersions(conn *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+	var versions []*route53.TrafficPolicy
+
+	for {
+		output, err := conn.ListTrafficPolicyVersions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, output.TrafficPolicies...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.TrafficPolicyVersionMarker = output.TrafficPolicyVersionMarker
+	}
+
+	return versions, nil
+}
+
+// getLatestRoute53TrafficPolicyVersion returns the highest numbered version of a traffic policy.
+func getLatestRoute53TrafficPolicyVersion(conn *route53.Route53, id string) (*route53.TrafficPolicy, error) {
+	versions, err := listRoute53TrafficPolicyVersions(conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *route53.TrafficPolicy
+
+	for _, version := range versions {
+		if version == nil {
+			continue
+		}
+
+		if latest == nil || aws.Int64Value(version.Version) > aws.Int64Value(latest.Version) {
+			latest = version
+		}
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The Route53 API does not define constants for traffic policy instance states.
+const (
+	route53TrafficPolicyInstanceStateApplied  = "Applied"
+	route53TrafficPolicyInstanceStateCreating = "Creating"
+	route53TrafficPolicyInstanceStateDeleting = "Deleting"
+	route53TrafficPolicyInstanceStateFailed   = "Failed"
+	route53TrafficPolicyInstanceStateUpdating = "Updating"
+)
+
+func resourceAwsRoute53TrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyInstanceCreate,
+		Read:   resourceAwsRoute53TrafficPolicyInstanceRead,
+		Update: resourceAwsRoute53TrafficPolicyInstanceUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSuffix(v.(string), ".")
+				},
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"traffic_policy_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+			"traffic_policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"traffic_policy_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return cleanZoneID(v.(string))
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+	name := d.Get("name").(string)
+
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(cleanZoneID(d.Get("zone_id").(string))),
+		Name:                 aws.String(name),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 Traffic Policy Instance: %s", input)
+	output, err := conn.CreateTrafficPolicyInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route53 Traffic Policy Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicyInstance.Id))
+
+	if err := waitForRoute53TrafficPolicyInstanceState(conn, d.Id(), route53TrafficPolicyInstanceStateCreating, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+		log.Printf("[WARN] Route53 Traffic Policy Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	instance := output.TrafficPolicyInstance
+
+	d.Set("name", strings.TrimSuffix(aws.StringValue(instance.Name), "."))
+	d.Set("state", instance.State)
+	d.Set("traffic_policy_id", instance.TrafficPolicyId)
+	d.Set("traffic_policy_type", instance.TrafficPolicyType)
+	d.Set("traffic_policy_version", instance.TrafficPolicyVersion)
+	d.Set("ttl", instance.TTL)
+	d.Set("zone_id", cleanZoneID(aws.StringValue(instance.HostedZoneId)))
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 Traffic Policy Instance: %s", input)
+	if _, err := conn.UpdateTrafficPolicyInstance(input); err != nil {
+		return fmt.Errorf("error updating Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRoute53TrafficPolicyInstanceState(conn, d.Id(), route53TrafficPolicyInstanceStateUpdating, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Route53 Traffic Policy Instance: %s", input)
+	_, err := conn.DeleteTrafficPolicyInstance(input)
+
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route53 Traffic Policy Instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			route53TrafficPolicyInstanceStateApplied,
+			route53TrafficPolicyInstanceStateDeleting,
+		},
+		Target:     []string{},
+		Refresh:    route53TrafficPolicyInstanceStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Route53 Traffic Policy Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func route53TrafficPolicyInstanceStateRefreshFunc(conn *route53.Route53, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(id),
+		})
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		instance := output.TrafficPolicyInstance
+		state := aws.StringValue(instance.State)
+
+		if state == route53TrafficPolicyInstanceStateFailed {
+			return instance, state, fmt.Errorf("%s", aws.StringValue(instance.Message))
+		}
+
+		return instance, state, nil
+	}
+}
+
+func waitForRoute53TrafficPolicyInstanceState(conn *route53.Route53, id, pending string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{pending},
+		Target:     []string{route53TrafficPolicyInstanceStateApplied},
+		Refresh:    route53TrafficPolicyInstanceStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for Route53 Traffic Policy Instance (%s) to be applied", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	var instance route53.TrafficPolicyInstance
+	resourceName := "aws_route53_traffic_policy_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.com", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, 360),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("www.%s", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, "state", "Applied"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_route53_traffic_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_type", "A"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_version", "aws_route53_traffic_policy.test", "version"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "360"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceExists(resourceName string, instance *route53.TrafficPolicyInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Traffic Policy Instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*instance = *output.TrafficPolicyInstance
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route53 Traffic Policy Instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyInstanceConfig(rName, zoneName string, ttl int) string {
+	return testAccRoute53TrafficPolicyConfig(rName, "", "192.0.2.1") + fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  name                   = "www.%[1]s"
+  traffic_policy_id      = "${aws_route53_traffic_policy.test.id}"
+  traffic_policy_version = "${aws_route53_traffic_policy.test.version}"
+  ttl                    = %[2]d
+  zone_id                = "${aws_route53_zone.test.zone_id}"
+}
+`, zoneName, ttl)
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicy_basic(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "document"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53TrafficPolicy_update(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment1", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment2", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccRoute53TrafficPolicyConfig(rName, "comment2", "192.0.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53TrafficPolicyExists(resourceName string, trafficPolicy *route53.TrafficPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 Traffic Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		output, err := getLatestRoute53TrafficPolicyVersion(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Route53 Traffic Policy (%s) not found", rs.Primary.ID)
+		}
+
+		*trafficPolicy = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRoute53TrafficPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		output, err := getLatestRoute53TrafficPolicyVersion(conn, rs.Primary.ID)
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Route53 Traffic Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccRoute53TrafficPolicyConfig(rName, comment, value string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name    = %[1]q
+  comment = %[2]q
+
+  document = <<EOT
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint": {
+      "Type": "value",
+      "Value": %[3]q
+    }
+  },
+  "StartEndpoint": "endpoint"
+}
+EOT
+}
+`, rName, comment, value)
+}
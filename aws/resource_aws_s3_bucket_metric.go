@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsS3BucketMetric() *schema.Resource {
@@ -162,7 +163,7 @@ func expandS3MetricsFilter(m map[string]interface{}) *s3.MetricsFilter {
 
 	var tags []*s3.Tag
 	if v, ok := m["tags"]; ok {
-		tags = tagsFromMapS3(v.(map[string]interface{}))
+		tags = keyvaluetags.New(v.(map[string]interface{})).IgnoreAws().S3Tags()
 	}
 
 	metricsFilter := &s3.MetricsFilter{}
@@ -192,7 +193,7 @@ func flattenS3MetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interfac
 			m["prefix"] = *and.Prefix
 		}
 		if and.Tags != nil {
-			m["tags"] = tagsToMapS3(and.Tags)
+			m["tags"] = keyvaluetags.S3KeyValueTags(and.Tags).IgnoreAws().Map()
 		}
 	} else if metricsFilter.Prefix != nil {
 		m["prefix"] = *metricsFilter.Prefix
@@ -200,7 +201,7 @@ func flattenS3MetricsFilter(metricsFilter *s3.MetricsFilter) map[string]interfac
 		tags := []*s3.Tag{
 			metricsFilter.Tag,
 		}
-		m["tags"] = tagsToMapS3(tags)
+		m["tags"] = keyvaluetags.S3KeyValueTags(tags).IgnoreAws().Map()
 	}
 	return m
 }
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubActionTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubActionTargetCreate,
+		Read:   resourceAwsSecurityHubActionTargetRead,
+		Update: resourceAwsSecurityHubActionTargetUpdate,
+		Delete: resourceAwsSecurityHubActionTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 20),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubActionTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.CreateActionTargetInput{
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(d.Get("identifier").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Action Target: %s", input)
+	output, err := conn.CreateActionTarget(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Action Target: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ActionTargetArn))
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	actionTarget, err := findSecurityHubActionTarget(conn, d.Id())
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Action Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	if actionTarget == nil {
+		log.Printf("[WARN] Security Hub Action Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	identifier, err := securityHubActionTargetParseIdentifier(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", actionTarget.ActionTargetArn)
+	d.Set("description", actionTarget.Description)
+	d.Set("identifier", identifier)
+	d.Set("name", actionTarget.Name)
+
+	return nil
+}
+
+func resourceAwsSecurityHubActionTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+		Description:     aws.String(d.Get("description").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub Action Target: %s", input)
+	if _, err := conn.UpdateActionTarget(input); err != nil {
+		return fmt.Errorf("error updating Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Action Target: %s", d.Id())
+	_, err := conn.DeleteActionTarget(&securityhub.DeleteActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Action Target (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findSecurityHubActionTarget(conn *securityhub.SecurityHub, actionTargetArn string) (*securityhub.ActionTarget, error) {
+	input := &securityhub.DescribeActionTargetsInput{
+		ActionTargetArns: []*string{aws.String(actionTargetArn)},
+	}
+	var result *securityhub.ActionTarget
+
+	err := conn.DescribeActionTargetsPages(input, func(page *securityhub.DescribeActionTargetsOutput, lastPage bool) bool {
+		for _, actionTarget := range page.ActionTargets {
+			if aws.StringValue(actionTarget.ActionTargetArn) == actionTargetArn {
+				result = actionTarget
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// securityHubActionTargetParseIdentifier returns the custom action identifier
+// from an ARN of the form arn:aws:securityhub:us-east-1:123456789012:action/custom/<identifier>.
+func securityHubActionTargetParseIdentifier(actionTargetArn string) (string, error) {
+	parts := strings.Split(actionTargetArn, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", fmt.Errorf("Unexpected format for Security Hub Action Target ARN (%q), expected <prefix>/custom/<identifier>", actionTargetArn)
+	}
+
+	return parts[2], nil
+}
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityHubInviteAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubInviteAccepterCreate,
+		Read:   resourceAwsSecurityHubInviteAccepterRead,
+		Delete: resourceAwsSecurityHubInviteAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubInviteAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+	masterID := d.Get("master_id").(string)
+
+	invitationID, err := findSecurityHubInvitationID(conn, masterID)
+
+	if err != nil {
+		return fmt.Errorf("error listing Security Hub Invitations: %s", err)
+	}
+
+	if invitationID == "" {
+		return fmt.Errorf("unable to find pending Security Hub Invitation from master account ID (%s)", masterID)
+	}
+
+	input := &securityhub.AcceptInvitationInput{
+		InvitationId: aws.String(invitationID),
+		MasterId:     aws.String(masterID),
+	}
+
+	log.Printf("[DEBUG] Accepting Security Hub Invitation: %s", input)
+	if _, err := conn.AcceptInvitation(input); err != nil {
+		return fmt.Errorf("error accepting Security Hub Invitation (%s): %s", invitationID, err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsSecurityHubInviteAccepterRead(d, meta)
+}
+
+func resourceAwsSecurityHubInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	output, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Master Account: %s", err)
+	}
+
+	master := output.Master
+
+	if master == nil {
+		log.Printf("[WARN] Security Hub Master Account for (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("invitation_id", master.InvitationId)
+	d.Set("master_id", master.AccountId)
+
+	return nil
+}
+
+func resourceAwsSecurityHubInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Disassociating Security Hub account (%s) from Security Hub Master Account", d.Id())
+	_, err := conn.DisassociateFromMasterAccount(&securityhub.DisassociateFromMasterAccountInput{})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Security Hub account (%s) from Security Hub Master Account: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findSecurityHubInvitationID(conn *securityhub.SecurityHub, masterID string) (string, error) {
+	input := &securityhub.ListInvitationsInput{}
+
+	for {
+		output, err := conn.ListInvitations(input)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, invitation := range output.Invitations {
+			if aws.StringValue(invitation.AccountId) == masterID {
+				return aws.StringValue(invitation.InvitationId), nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return "", nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubInviteAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_securityhub_invite_accepter.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSSecurityHubInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubInviteAccepterConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubInviteAccepterExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "master_id", "aws_securityhub_member.example", "master_id"),
+				),
+			},
+			{
+				Config:            testAccAWSSecurityHubInviteAccepterConfig_basic(),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubInviteAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		output, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Master == nil || aws.StringValue(output.Master.AccountId) == "" {
+			return fmt.Errorf("no Security Hub master account found for: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubInviteAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_invite_accepter" {
+			continue
+		}
+
+		output, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+		// Security Hub returns this error when the account is no longer subscribed
+		if isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Master != nil && aws.StringValue(output.Master.AccountId) == rs.Primary.Attributes["master_id"] {
+			return fmt.Errorf("Security Hub account (%s) still associated with Security Hub master account (%s)", rs.Primary.ID, aws.StringValue(output.Master.AccountId))
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubInviteAccepterConfig_basic() string {
+	return testAccAlternateAccountProviderConfig() + `
+data "aws_caller_identity" "accepter" {}
+
+resource "aws_securityhub_account" "example" {
+  provider = "aws.alternate"
+}
+
+resource "aws_securityhub_member" "example" {
+  provider = "aws.alternate"
+
+  depends_on = ["aws_securityhub_account.example"]
+  account_id = "${data.aws_caller_identity.accepter.account_id}"
+  email      = "example@example.com"
+  invite     = true
+}
+
+resource "aws_securityhub_account" "accepter" {}
+
+resource "aws_securityhub_invite_accepter" "example" {
+  depends_on = ["aws_securityhub_account.accepter"]
+  master_id  = "${aws_securityhub_member.example.master_id}"
+}
+`
+}
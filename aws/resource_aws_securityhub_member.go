@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	securityHubMemberStatusAssociated = "Associated"
+	securityHubMemberStatusInvited    = "Invited"
+)
+
+func resourceAwsSecurityHubMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubMemberCreate,
+		Read:   resourceAwsSecurityHubMemberRead,
+		Update: resourceAwsSecurityHubMemberUpdate,
+		Delete: resourceAwsSecurityHubMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"invite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"master_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+	accountID := d.Get("account_id").(string)
+
+	input := &securityhub.CreateMembersInput{
+		AccountDetails: []*securityhub.AccountDetails{
+			{
+				AccountId: aws.String(accountID),
+				Email:     aws.String(d.Get("email").(string)),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub Member: %s", input)
+	output, err := conn.CreateMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub Member (%s): %s", accountID, err)
+	}
+
+	if len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error creating Security Hub Member (%s): %s", accountID, aws.StringValue(output.UnprocessedAccounts[0].ProcessingResult))
+	}
+
+	d.SetId(accountID)
+
+	if d.Get("invite").(bool) {
+		if err := inviteSecurityHubMember(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecurityHubMemberRead(d, meta)
+}
+
+func resourceAwsSecurityHubMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	output, err := conn.GetMembers(&securityhub.GetMembersInput{
+		AccountIds: []*string{aws.String(d.Id())},
+	})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub Member (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Members) == 0 {
+		log.Printf("[WARN] Security Hub Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	member := output.Members[0]
+	status := aws.StringValue(member.MemberStatus)
+
+	d.Set("account_id", member.AccountId)
+	d.Set("email", member.Email)
+	d.Set("master_id", member.MasterId)
+	d.Set("member_status", status)
+	d.Set("invite", status == securityHubMemberStatusInvited || status == securityHubMemberStatusAssociated)
+
+	return nil
+}
+
+func resourceAwsSecurityHubMemberUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	if d.HasChange("invite") {
+		if d.Get("invite").(bool) {
+			if err := inviteSecurityHubMember(conn, d.Id()); err != nil {
+				return err
+			}
+		} else {
+			input := &securityhub.DisassociateMembersInput{
+				AccountIds: []*string{aws.String(d.Id())},
+			}
+
+			log.Printf("[DEBUG] Disassociating Security Hub Member: %s", input)
+			if _, err := conn.DisassociateMembers(input); err != nil {
+				return fmt.Errorf("error disassociating Security Hub Member (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsSecurityHubMemberRead(d, meta)
+}
+
+func resourceAwsSecurityHubMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub Member: %s", d.Id())
+	output, err := conn.DeleteMembers(&securityhub.DeleteMembersInput{
+		AccountIds: []*string{aws.String(d.Id())},
+	})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub Member (%s): %s", d.Id(), err)
+	}
+
+	if len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error deleting Security Hub Member (%s): %s", d.Id(), aws.StringValue(output.UnprocessedAccounts[0].ProcessingResult))
+	}
+
+	return nil
+}
+
+func inviteSecurityHubMember(conn *securityhub.SecurityHub, accountID string) error {
+	input := &securityhub.InviteMembersInput{
+		AccountIds: []*string{aws.String(accountID)},
+	}
+
+	log.Printf("[DEBUG] Inviting Security Hub Member: %s", input)
+	output, err := conn.InviteMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error inviting Security Hub Member (%s): %s", accountID, err)
+	}
+
+	if len(output.UnprocessedAccounts) > 0 {
+		return fmt.Errorf("error inviting Security Hub Member (%s): %s", accountID, aws.StringValue(output.UnprocessedAccounts[0].ProcessingResult))
+	}
+
+	return nil
+}
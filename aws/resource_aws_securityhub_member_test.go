@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubMember_basic(t *testing.T) {
+	var member securityhub.Member
+	resourceName := "aws_securityhub_member.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubMemberConfig_basic("111111111111", "example@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "account_id", "111111111111"),
+					resource.TestCheckResourceAttr(resourceName, "email", "example@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubMember_invite(t *testing.T) {
+	var member securityhub.Member
+	resourceName := "aws_securityhub_member.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubMemberConfig_invite("111111111111", "example@example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "member_status", "Invited"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Disassociate member
+			{
+				Config: testAccAWSSecurityHubMemberConfig_invite("111111111111", "example@example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "member_status", "Removed"),
+				),
+			},
+			// Invite member again
+			{
+				Config: testAccAWSSecurityHubMemberConfig_invite("111111111111", "example@example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName, &member),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "member_status", "Invited"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubMemberExists(n string, member *securityhub.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		output, err := conn.GetMembers(&securityhub.GetMembersInput{
+			AccountIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Members) == 0 {
+			return fmt.Errorf("Security Hub member %s not found", rs.Primary.ID)
+		}
+
+		*member = *output.Members[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_member" {
+			continue
+		}
+
+		output, err := conn.GetMembers(&securityhub.GetMembersInput{
+			AccountIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
+			// Security Hub returns this error when the account is no longer subscribed
+			if isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+				return nil
+			}
+			return err
+		}
+
+		if len(output.Members) != 0 {
+			return fmt.Errorf("Security Hub member still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubMemberConfig_basic(accountID, email string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "example" {}
+
+resource "aws_securityhub_member" "example" {
+  depends_on = ["aws_securityhub_account.example"]
+  account_id = "%s"
+  email      = "%s"
+}
+`, accountID, email)
+}
+
+func testAccAWSSecurityHubMemberConfig_invite(accountID, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "example" {}
+
+resource "aws_securityhub_member" "example" {
+  depends_on = ["aws_securityhub_account.example"]
+  account_id = "%s"
+  email      = "%s"
+  invite     = %t
+}
+`, accountID, email, invite)
+}
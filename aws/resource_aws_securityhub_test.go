@@ -9,6 +9,16 @@ func TestAccAWSSecurityHub(t *testing.T) {
 		"Account": {
 			"basic": testAccAWSSecurityHubAccount_basic,
 		},
+		"ActionTarget": {
+			"basic": testAccAWSSecurityHubActionTarget_basic,
+		},
+		"InviteAccepter": {
+			"basic": testAccAWSSecurityHubInviteAccepter_basic,
+		},
+		"Member": {
+			"basic":  testAccAWSSecurityHubMember_basic,
+			"invite": testAccAWSSecurityHubMember_invite,
+		},
 		"ProductSubscription": {
 			"basic": testAccAWSSecurityHubProductSubscription_basic,
 		},
@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"distributor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProductTypeCloudFormationTemplate,
+				}, false),
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"disable_template_validation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"template_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.CreateProductInput{
+		AcceptLanguage:                 aws.String("en"),
+		IdempotencyToken:               aws.String(resource.UniqueId()),
+		Name:                           aws.String(d.Get("name").(string)),
+		Owner:                          aws.String(d.Get("owner").(string)),
+		ProductType:                    aws.String(d.Get("product_type").(string)),
+		ProvisioningArtifactParameters: expandServiceCatalogProvisioningArtifactParameters(d.Get("provisioning_artifact_parameters").([]interface{})),
+		Tags:                           keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ServicecatalogTags(),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", input)
+	resp, err := conn.CreateProduct(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Product: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.ProductViewDetail.ProductViewSummary.ProductId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: serviceCatalogProductStatusRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Product (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.ProductViewDetail
+	summary := detail.ProductViewSummary
+
+	d.Set("arn", detail.ProductARN)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("has_default_path", summary.HasDefaultPath)
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("product_type", summary.Type)
+	d.Set("status", detail.Status)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+
+	if err := setTagsAll(d, meta, keyvaluetags.ServicecatalogKeyValueTags(resp.Tags).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("distributor") {
+		input.Distributor = aws.String(d.Get("distributor").(string))
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	if d.HasChange("owner") {
+		input.Owner = aws.String(d.Get("owner").(string))
+	}
+
+	if d.HasChange("support_description") {
+		input.SupportDescription = aws.String(d.Get("support_description").(string))
+	}
+
+	if d.HasChange("support_email") {
+		input.SupportEmail = aws.String(d.Get("support_email").(string))
+	}
+
+	if d.HasChange("support_url") {
+		input.SupportUrl = aws.String(d.Get("support_url").(string))
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+
+		if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+			input.RemoveTags = aws.StringSlice(removedTags.IgnoreAws().Keys())
+		}
+
+		if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+			input.AddTags = updatedTags.IgnoreAws().ServicecatalogTags()
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Product: %s", input)
+	if _, err := conn.UpdateProduct(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog Product: %s", d.Id())
+	_, err := conn.DeleteProduct(&servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func serviceCatalogProductStatusRefreshFunc(conn *servicecatalog.ServiceCatalog, productID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(productID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || resp.ProductViewDetail == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(resp.ProductViewDetail.Status)
+
+		if status == servicecatalog.StatusFailed {
+			return resp, status, fmt.Errorf("Service Catalog Product (%s) creation failed", productID)
+		}
+
+		return resp, status, nil
+	}
+}
+
+func expandServiceCatalogProvisioningArtifactParameters(l []interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &servicecatalog.ProvisioningArtifactProperties{
+		DisableTemplateValidation: aws.Bool(m["disable_template_validation"].(bool)),
+		Info: map[string]*string{
+			"LoadTemplateFromURL": aws.String(m["template_url"].(string)),
+		},
+		Type: aws.String(m["type"].(string)),
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		properties.Description = aws.String(v)
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		properties.Name = aws.String(v)
+	}
+
+	return properties
+}
@@ -0,0 +1,365 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsServiceCatalogProvisionedProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisionedProductCreate,
+		Read:   resourceAwsServiceCatalogProvisionedProductRead,
+		Update: resourceAwsServiceCatalogProvisionedProductUpdate,
+		Delete: resourceAwsServiceCatalogProvisionedProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"notification_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"path_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisionedProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.ProvisionProductInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(d.Get("product_id").(string)),
+		ProvisionToken:         aws.String(resource.UniqueId()),
+		ProvisionedProductName: aws.String(d.Get("name").(string)),
+		ProvisioningArtifactId: aws.String(d.Get("provisioning_artifact_id").(string)),
+		Tags:                   keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ServicecatalogTags(),
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationArns = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok {
+		input.ProvisioningParameters = expandServiceCatalogProvisioningParameters(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioned Product: %s", input)
+	resp, err := conn.ProvisionProduct(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioned Product: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.RecordDetail.ProvisionedProductId))
+
+	if err := waitForServiceCatalogRecord(conn, aws.StringValue(resp.RecordDetail.RecordId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	resp, err := conn.DescribeProvisionedProduct(&servicecatalog.DescribeProvisionedProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Provisioned Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.ProvisionedProductDetail
+
+	d.Set("arn", detail.Arn)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("last_record_id", detail.LastRecordId)
+	d.Set("name", detail.Name)
+	d.Set("product_id", detail.ProductId)
+	d.Set("provisioning_artifact_id", detail.ProvisioningArtifactId)
+	d.Set("status", detail.Status)
+	d.Set("status_message", detail.StatusMessage)
+	d.Set("type", detail.Type)
+
+	// The path, tags and outputs are only available from the last record.
+	record, err := conn.DescribeRecord(&servicecatalog.DescribeRecordInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             detail.LastRecordId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioned Product (%s) record (%s): %s", d.Id(), aws.StringValue(detail.LastRecordId), err)
+	}
+
+	d.Set("path_id", record.RecordDetail.PathId)
+
+	if err := d.Set("outputs", flattenServiceCatalogRecordOutputs(record.RecordOutputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %s", err)
+	}
+
+	tags := make(map[string]string)
+	for _, tag := range record.RecordDetail.RecordTags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	if err := setTagsAll(d, meta, keyvaluetags.New(tags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisionedProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProvisionedProductInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(d.Get("product_id").(string)),
+		ProvisionedProductId:   aws.String(d.Id()),
+		ProvisioningArtifactId: aws.String(d.Get("provisioning_artifact_id").(string)),
+		UpdateToken:            aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("path_id"); ok {
+		input.PathId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("provisioning_parameters"); ok {
+		input.ProvisioningParameters = expandServiceCatalogUpdateProvisioningParameters(v.([]interface{}))
+	}
+
+	if d.HasChange("tags_all") {
+		// Tags on the provisioned product are replaced as a whole.
+		input.Tags = keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().ServicecatalogTags()
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioned Product: %s", input)
+	resp, err := conn.UpdateProvisionedProduct(input)
+	if err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForServiceCatalogRecord(conn, aws.StringValue(resp.RecordDetail.RecordId), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisionedProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisionedProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioned Product: %s", d.Id())
+	resp, err := conn.TerminateProvisionedProduct(&servicecatalog.TerminateProvisionedProductInput{
+		AcceptLanguage:       aws.String("en"),
+		ProvisionedProductId: aws.String(d.Id()),
+		TerminateToken:       aws.String(resource.UniqueId()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioned Product (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForServiceCatalogRecord(conn, aws.StringValue(resp.RecordDetail.RecordId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Provisioned Product (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func waitForServiceCatalogRecord(conn *servicecatalog.ServiceCatalog, recordID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.RecordStatusCreated,
+			servicecatalog.RecordStatusInProgress,
+			servicecatalog.RecordStatusInProgressInError,
+		},
+		Target:  []string{servicecatalog.RecordStatusSucceeded},
+		Refresh: serviceCatalogRecordStatusRefreshFunc(conn, recordID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func serviceCatalogRecordStatusRefreshFunc(conn *servicecatalog.ServiceCatalog, recordID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeRecord(&servicecatalog.DescribeRecordInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(recordID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp == nil || resp.RecordDetail == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(resp.RecordDetail.Status)
+
+		if status == servicecatalog.RecordStatusFailed {
+			var errors []string
+			for _, e := range resp.RecordDetail.RecordErrors {
+				errors = append(errors, fmt.Sprintf("%s: %s", aws.StringValue(e.Code), aws.StringValue(e.Description)))
+			}
+			return resp, status, fmt.Errorf("record (%s) failed: %s", recordID, strings.Join(errors, ", "))
+		}
+
+		return resp, status, nil
+	}
+}
+
+func expandServiceCatalogProvisioningParameters(l []interface{}) []*servicecatalog.ProvisioningParameter {
+	parameters := make([]*servicecatalog.ProvisioningParameter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, &servicecatalog.ProvisioningParameter{
+			Key:   aws.String(m["key"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	return parameters
+}
+
+func expandServiceCatalogUpdateProvisioningParameters(l []interface{}) []*servicecatalog.UpdateProvisioningParameter {
+	parameters := make([]*servicecatalog.UpdateProvisioningParameter, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, &servicecatalog.UpdateProvisioningParameter{
+			Key:   aws.String(m["key"].(string)),
+			Value: aws.String(m["value"].(string)),
+		})
+	}
+
+	return parameters
+}
+
+func flattenServiceCatalogRecordOutputs(outputs []*servicecatalog.RecordOutput) map[string]string {
+	m := make(map[string]string, len(outputs))
+
+	for _, output := range outputs {
+		m[aws.StringValue(output.OutputKey)] = aws.StringValue(output.OutputValue)
+	}
+
+	return m
+}
@@ -0,0 +1,248 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disable_template_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"guidance": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  servicecatalog.ProvisioningArtifactGuidanceDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactGuidanceDefault,
+					servicecatalog.ProvisioningArtifactGuidanceDeprecated,
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters: &servicecatalog.ProvisioningArtifactProperties{
+			DisableTemplateValidation: aws.Bool(d.Get("disable_template_validation").(bool)),
+			Info: map[string]*string{
+				"LoadTemplateFromURL": aws.String(d.Get("template_url").(string)),
+			},
+			Type: aws.String(d.Get("type").(string)),
+		},
+		ProductId: aws.String(productID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Parameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Parameters.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	resp, err := conn.CreateProvisioningArtifact(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact: %s", err)
+	}
+
+	artifactID := aws.StringValue(resp.ProvisioningArtifactDetail.Id)
+	d.SetId(serviceCatalogProvisioningArtifactCreateID(productID, artifactID))
+
+	// Guidance and the active flag can only be set by an update.
+	if !d.Get("active").(bool) || d.Get("guidance").(string) != servicecatalog.ProvisioningArtifactGuidanceDefault {
+		if err := serviceCatalogProvisioningArtifactUpdate(conn, d, productID, artifactID); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := serviceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.ProvisioningArtifactDetail
+
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", detail.Description)
+	d.Set("guidance", detail.Guidance)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", detail.Id)
+	d.Set("type", detail.Type)
+
+	if v, ok := resp.Info["TemplateUrl"]; ok {
+		d.Set("template_url", v)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := serviceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := serviceCatalogProvisioningArtifactUpdate(conn, d, productID, artifactID); err != nil {
+		return err
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := serviceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact: %s", d.Id())
+	_, err = conn.DeleteProvisioningArtifact(&servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func serviceCatalogProvisioningArtifactUpdate(conn *servicecatalog.ServiceCatalog, d *schema.ResourceData, productID, artifactID string) error {
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		Guidance:               aws.String(d.Get("guidance").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	if _, err := conn.UpdateProvisioningArtifact(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func serviceCatalogProvisioningArtifactCreateID(productID, artifactID string) string {
+	return strings.Join([]string{productID, artifactID}, ",")
+}
+
+func serviceCatalogProvisioningArtifactParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format for ID (%q), expected product-id,provisioning-artifact-id", id)
+	}
+
+	return parts[0], parts[1], nil
+}
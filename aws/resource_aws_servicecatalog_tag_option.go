@@ -0,0 +1,138 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogTagOption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionCreate,
+		Read:   resourceAwsServiceCatalogTagOptionRead,
+		Update: resourceAwsServiceCatalogTagOptionUpdate,
+		Delete: resourceAwsServiceCatalogTagOptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.CreateTagOptionInput{
+		Key:   aws.String(d.Get("key").(string)),
+		Value: aws.String(d.Get("value").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option: %s", input)
+	resp, err := conn.CreateTagOption(input)
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Tag Option: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.TagOptionDetail.Id))
+
+	// Tag options are always created active.
+	if !d.Get("active").(bool) {
+		_, err := conn.UpdateTagOption(&servicecatalog.UpdateTagOptionInput{
+			Active: aws.Bool(false),
+			Id:     aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog Tag Option (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	resp, err := conn.DescribeTagOption(&servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Tag Option (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Tag Option (%s): %s", d.Id(), err)
+	}
+
+	detail := resp.TagOptionDetail
+
+	d.Set("active", detail.Active)
+	d.Set("key", detail.Key)
+	d.Set("value", detail.Value)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateTagOptionInput{
+		Id: aws.String(d.Id()),
+	}
+
+	if d.HasChange("active") {
+		input.Active = aws.Bool(d.Get("active").(bool))
+	}
+
+	if d.HasChange("value") {
+		input.Value = aws.String(d.Get("value").(string))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Tag Option: %s", input)
+	if _, err := conn.UpdateTagOption(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Tag Option (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogTagOptionRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	log.Printf("[DEBUG] Deleting Service Catalog Tag Option: %s", d.Id())
+	_, err := conn.DeleteTagOption(&servicecatalog.DeleteTagOptionInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Tag Option (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
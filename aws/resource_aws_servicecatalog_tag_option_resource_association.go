@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogTagOptionResourceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTagOptionResourceAssociationCreate,
+		Read:   resourceAwsServiceCatalogTagOptionResourceAssociationRead,
+		Delete: resourceAwsServiceCatalogTagOptionResourceAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_option_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID := d.Get("tag_option_id").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	input := &servicecatalog.AssociateTagOptionWithResourceInput{
+		ResourceId:  aws.String(resourceID),
+		TagOptionId: aws.String(tagOptionID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Tag Option Resource Association: %s", input)
+	if _, err := conn.AssociateTagOptionWithResource(input); err != nil {
+		return fmt.Errorf("error associating Service Catalog Tag Option (%s) with resource (%s): %s", tagOptionID, resourceID, err)
+	}
+
+	d.SetId(strings.Join([]string{tagOptionID, resourceID}, ","))
+
+	return resourceAwsServiceCatalogTagOptionResourceAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID, resourceID, err := serviceCatalogTagOptionResourceAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListResourcesForTagOptionInput{
+		TagOptionId: aws.String(tagOptionID),
+	}
+
+	var detail *servicecatalog.ResourceDetail
+	err = conn.ListResourcesForTagOptionPages(input, func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+		for _, r := range page.ResourceDetails {
+			if aws.StringValue(r.Id) == resourceID {
+				detail = r
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Tag Option Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Tag Option Resource Association (%s): %s", d.Id(), err)
+	}
+
+	if detail == nil {
+		log.Printf("[WARN] Service Catalog Tag Option Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", detail.ARN)
+	d.Set("resource_id", resourceID)
+	d.Set("resource_name", detail.Name)
+	d.Set("tag_option_id", tagOptionID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTagOptionResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	tagOptionID, resourceID, err := serviceCatalogTagOptionResourceAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Tag Option Resource Association: %s", d.Id())
+	_, err = conn.DisassociateTagOptionFromResource(&servicecatalog.DisassociateTagOptionFromResourceInput{
+		ResourceId:  aws.String(resourceID),
+		TagOptionId: aws.String(tagOptionID),
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Tag Option (%s) from resource (%s): %s", tagOptionID, resourceID, err)
+	}
+
+	return nil
+}
+
+func serviceCatalogTagOptionResourceAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format for ID (%q), expected tag-option-id,resource-id", id)
+	}
+
+	return parts[0], parts[1], nil
+}
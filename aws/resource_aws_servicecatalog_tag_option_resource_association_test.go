@@ -0,0 +1,127 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogTagOptionResourceAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_tag_option_resource_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogTagOptionResourceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTagOptionResourceAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogTagOptionResourceAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_servicecatalog_portfolio.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "tag_option_id", "aws_servicecatalog_tag_option.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogTagOptionResourceAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		found, err := testAccServiceCatalogTagOptionResourceAssociationFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Service Catalog Tag Option Resource Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAwsServiceCatalogTagOptionResourceAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_tag_option_resource_association" {
+			continue
+		}
+
+		found, err := testAccServiceCatalogTagOptionResourceAssociationFound(rs.Primary.ID)
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Service Catalog Tag Option Resource Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceCatalogTagOptionResourceAssociationFound(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	tagOptionID, resourceID, err := serviceCatalogTagOptionResourceAssociationParseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	err = conn.ListResourcesForTagOptionPages(&servicecatalog.ListResourcesForTagOptionInput{
+		TagOptionId: aws.String(tagOptionID),
+	}, func(page *servicecatalog.ListResourcesForTagOptionOutput, lastPage bool) bool {
+		for _, r := range page.ResourceDetails {
+			if aws.StringValue(r.Id) == resourceID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSServiceCatalogTagOptionResourceAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = "test"
+}
+
+resource "aws_servicecatalog_tag_option" "test" {
+  key   = %[1]q
+  value = "value"
+}
+
+resource "aws_servicecatalog_tag_option_resource_association" "test" {
+  resource_id   = "${aws_servicecatalog_portfolio.test.id}"
+  tag_option_id = "${aws_servicecatalog_tag_option.test.id}"
+}
+`, rName)
+}
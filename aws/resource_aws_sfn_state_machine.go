@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func resourceAwsSfnStateMachine() *schema.Resource {
@@ -23,6 +24,8 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"definition": {
 				Type:         schema.TypeString,
@@ -52,7 +55,8 @@ func resourceAwsSfnStateMachine() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
 		},
 	}
 }
@@ -65,7 +69,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 		Definition: aws.String(d.Get("definition").(string)),
 		Name:       aws.String(d.Get("name").(string)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
-		Tags:       tagsFromMapSfn(d.Get("tags").(map[string]interface{})),
+		Tags:       keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().SfnTags(),
 	}
 
 	var activity *sfn.CreateStateMachineOutput
@@ -102,6 +106,7 @@ func resourceAwsSfnStateMachineCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sfnconn
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
 	log.Printf("[DEBUG] Reading Step Function State Machine: %s", d.Id())
 
 	sm, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
@@ -140,10 +145,10 @@ func resourceAwsSfnStateMachineRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if tagsResp != nil {
-		tags = tagsToMapSfn(tagsResp.Tags)
+		tags = keyvaluetags.SfnKeyValueTags(tagsResp.Tags).Map()
 	}
 
-	if err := d.Set("tags", tags); err != nil {
+	if err := setTagsAll(d, meta, keyvaluetags.New(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
@@ -170,39 +175,11 @@ func resourceAwsSfnStateMachineUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
-		oldTagsRaw, newTagsRaw := d.GetChange("tags")
-		oldTagsMap := oldTagsRaw.(map[string]interface{})
-		newTagsMap := newTagsRaw.(map[string]interface{})
-		createTags, removeTags := diffTagsSfn(tagsFromMapSfn(oldTagsMap), tagsFromMapSfn(newTagsMap))
-
-		if len(removeTags) > 0 {
-			removeTagKeys := make([]*string, len(removeTags))
-			for i, removeTag := range removeTags {
-				removeTagKeys[i] = removeTag.Key
-			}
-
-			input := &sfn.UntagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				TagKeys:     removeTagKeys,
-			}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
-			log.Printf("[DEBUG] Untagging State Function: %s", input)
-			if _, err := conn.UntagResource(input); err != nil {
-				return fmt.Errorf("error untagging State Function (%s): %s", d.Id(), err)
-			}
-		}
-
-		if len(createTags) > 0 {
-			input := &sfn.TagResourceInput{
-				ResourceArn: aws.String(d.Id()),
-				Tags:        createTags,
-			}
-
-			log.Printf("[DEBUG] Tagging State Function: %s", input)
-			if _, err := conn.TagResource(input); err != nil {
-				return fmt.Errorf("error tagging State Function (%s): %s", d.Id(), err)
-			}
+		if err := keyvaluetags.SfnUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating Step Function State Machine (%s) tags: %s", d.Id(), err)
 		}
 	}
 	return resourceAwsSfnStateMachineRead(d, meta)
@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsShieldDrtAccessLogBucketAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldDrtAccessLogBucketAssociationCreate,
+		Read:   resourceAwsShieldDrtAccessLogBucketAssociationRead,
+		Delete: resourceAwsShieldDrtAccessLogBucketAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldDrtAccessLogBucketAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	logBucket := d.Get("log_bucket").(string)
+	input := &shield.AssociateDRTLogBucketInput{
+		LogBucket: aws.String(logBucket),
+	}
+
+	log.Printf("[DEBUG] Creating Shield DRT Access Log Bucket Association: %s", input)
+
+	// Retry while a newly associated DRT role, or its access to the bucket,
+	// propagates.
+	err := resource.Retry(shieldDrtAccessPropagationTimeout, func() *resource.RetryError {
+		_, err := conn.AssociateDRTLogBucket(input)
+
+		if isAWSErr(err, shield.ErrCodeNoAssociatedRoleException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if isAWSErr(err, shield.ErrCodeAccessDeniedForDependencyException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.AssociateDRTLogBucket(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Shield DRT Access Log Bucket Association (%s): %s", logBucket, err)
+	}
+
+	d.SetId(logBucket)
+
+	return resourceAwsShieldDrtAccessLogBucketAssociationRead(d, meta)
+}
+
+func resourceAwsShieldDrtAccessLogBucketAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield DRT Access Log Bucket Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Access Log Bucket Association (%s): %s", d.Id(), err)
+	}
+
+	found := false
+	for _, logBucket := range output.LogBucketList {
+		if aws.StringValue(logBucket) == d.Id() {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[WARN] Shield DRT Access Log Bucket Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("log_bucket", d.Id())
+
+	return nil
+}
+
+func resourceAwsShieldDrtAccessLogBucketAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.DisassociateDRTLogBucketInput{
+		LogBucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Shield DRT Access Log Bucket Association: %s", input)
+	_, err := conn.DisassociateDRTLogBucket(input)
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield DRT Access Log Bucket Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
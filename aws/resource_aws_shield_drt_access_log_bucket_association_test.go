@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldDrtAccessLogBucketAssociation_basic(t *testing.T) {
+	resourceName := "aws_shield_drt_access_log_bucket_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// DRT access is a per-account setting, so these tests are not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldDrtAccessLogBucketAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldDrtAccessLogBucketAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldDrtAccessLogBucketAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "log_bucket", "aws_s3_bucket.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldDrtAccessLogBucketAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_drt_access_log_bucket_association" {
+			continue
+		}
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, logBucket := range output.LogBucketList {
+			if aws.StringValue(logBucket) == rs.Primary.ID {
+				return fmt.Errorf("Shield DRT Access Log Bucket Association (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldDrtAccessLogBucketAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if err != nil {
+			return err
+		}
+
+		for _, logBucket := range output.LogBucketList {
+			if aws.StringValue(logBucket) == rs.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Shield DRT Access Log Bucket Association (%s) not found", rs.Primary.ID)
+	}
+}
+
+func testAccShieldDrtAccessLogBucketAssociationConfig(rName string) string {
+	return testAccShieldDrtAccessRoleArnAssociationConfig(rName, "test") + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_shield_drt_access_log_bucket_association" "test" {
+  log_bucket = "${aws_s3_bucket.test.id}"
+
+  depends_on = ["aws_shield_drt_access_role_arn_association.test"]
+}
+`, rName)
+}
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// Maximum amount of time to wait for DRT access role and log bucket
+	// permissions to propagate.
+	shieldDrtAccessPropagationTimeout = 2 * time.Minute
+)
+
+func resourceAwsShieldDrtAccessRoleArnAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldDrtAccessRoleArnAssociationCreate,
+		Read:   resourceAwsShieldDrtAccessRoleArnAssociationRead,
+		Update: resourceAwsShieldDrtAccessRoleArnAssociationUpdate,
+		Delete: resourceAwsShieldDrtAccessRoleArnAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	if err := associateShieldDrtRole(conn, d.Get("role_arn").(string)); err != nil {
+		return fmt.Errorf("error creating Shield DRT Access Role ARN Association: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsShieldDrtAccessRoleArnAssociationRead(d, meta)
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield DRT Access Role ARN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Access Role ARN Association (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.RoleArn) == "" {
+		log.Printf("[WARN] Shield DRT Access Role ARN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_arn", output.RoleArn)
+
+	return nil
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	// Associating a new role replaces the existing association.
+	if err := associateShieldDrtRole(conn, d.Get("role_arn").(string)); err != nil {
+		return fmt.Errorf("error updating Shield DRT Access Role ARN Association (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsShieldDrtAccessRoleArnAssociationRead(d, meta)
+}
+
+func resourceAwsShieldDrtAccessRoleArnAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	log.Printf("[DEBUG] Deleting Shield DRT Access Role ARN Association: %s", d.Id())
+	_, err := conn.DisassociateDRTRole(&shield.DisassociateDRTRoleInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield DRT Access Role ARN Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func associateShieldDrtRole(conn *shield.Shield, roleArn string) error {
+	input := &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleArn),
+	}
+
+	log.Printf("[DEBUG] Associating Shield DRT Role: %s", input)
+
+	// Retry for IAM eventual consistency of a newly created role, which Shield
+	// reports as an invalid parameter.
+	err := resource.Retry(shieldDrtAccessPropagationTimeout, func() *resource.RetryError {
+		_, err := conn.AssociateDRTRole(input)
+
+		if isAWSErr(err, shield.ErrCodeInvalidParameterException, "") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.AssociateDRTRole(input)
+	}
+
+	return err
+}
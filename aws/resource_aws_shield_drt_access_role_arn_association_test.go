@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldDrtAccessRoleArnAssociation_basic(t *testing.T) {
+	resourceName := "aws_shield_drt_access_role_arn_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	// The DRT access role is a per-account setting, so these tests are not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldDrtAccessRoleArnAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldDrtAccessRoleArnAssociationConfig(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldDrtAccessRoleArnAssociationConfig(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldDrtAccessRoleArnAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_drt_access_role_arn_association" {
+			continue
+		}
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) != "" {
+			return fmt.Errorf("Shield DRT Access Role ARN Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldDrtAccessRoleArnAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) != rs.Primary.Attributes["role_arn"] {
+			return fmt.Errorf("Shield DRT Access Role ARN Association (%s) has role %s, expected %s", rs.Primary.ID, aws.StringValue(output.RoleArn), rs.Primary.Attributes["role_arn"])
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldDrtAccessRoleArnAssociationConfigRoles(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "drt.shield.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = "${aws_iam_role.test.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSShieldDRTAccessPolicy"
+}
+
+resource "aws_iam_role" "test2" {
+  name               = "%[1]s-2"
+  assume_role_policy = "${aws_iam_role.test.assume_role_policy}"
+}
+
+resource "aws_iam_role_policy_attachment" "test2" {
+  role       = "${aws_iam_role.test2.name}"
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSShieldDRTAccessPolicy"
+}
+`, rName)
+}
+
+func testAccShieldDrtAccessRoleArnAssociationConfig(rName, roleName string) string {
+	return testAccShieldDrtAccessRoleArnAssociationConfigRoles(rName) + fmt.Sprintf(`
+resource "aws_shield_drt_access_role_arn_association" "test" {
+  role_arn = "${aws_iam_role.%[1]s.arn}"
+
+  depends_on = ["aws_iam_role_policy_attachment.test", "aws_iam_role_policy_attachment.test2"]
+}
+`, roleName)
+}
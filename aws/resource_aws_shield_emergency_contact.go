@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsShieldEmergencyContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsShieldEmergencyContactCreate,
+		Read:   resourceAwsShieldEmergencyContactRead,
+		Update: resourceAwsShieldEmergencyContactUpdate,
+		Delete: resourceAwsShieldEmergencyContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsShieldEmergencyContactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: expandShieldEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Shield Emergency Contact: %s", input)
+	if _, err := conn.UpdateEmergencyContactSettings(input); err != nil {
+		return fmt.Errorf("error creating Shield Emergency Contact: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsShieldEmergencyContactRead(d, meta)
+}
+
+func resourceAwsShieldEmergencyContactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	output, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Shield Emergency Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Emergency Contact (%s): %s", d.Id(), err)
+	}
+
+	if len(output.EmergencyContactList) == 0 {
+		log.Printf("[WARN] Shield Emergency Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("emergency_contact", flattenShieldEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsShieldEmergencyContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: expandShieldEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Shield Emergency Contact: %s", input)
+	if _, err := conn.UpdateEmergencyContactSettings(input); err != nil {
+		return fmt.Errorf("error updating Shield Emergency Contact (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsShieldEmergencyContactRead(d, meta)
+}
+
+func resourceAwsShieldEmergencyContactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).shieldconn
+
+	input := &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	}
+
+	log.Printf("[DEBUG] Deleting Shield Emergency Contact: %s", d.Id())
+	_, err := conn.UpdateEmergencyContactSettings(input)
+
+	if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Emergency Contact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandShieldEmergencyContacts(l []interface{}) []*shield.EmergencyContact {
+	contacts := make([]*shield.EmergencyContact, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		contacts = append(contacts, &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		})
+	}
+
+	return contacts
+}
+
+func flattenShieldEmergencyContacts(contacts []*shield.EmergencyContact) []interface{} {
+	l := make([]interface{}, 0, len(contacts))
+
+	for _, contact := range contacts {
+		if contact == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"email_address": aws.StringValue(contact.EmailAddress),
+		})
+	}
+
+	return l
+}
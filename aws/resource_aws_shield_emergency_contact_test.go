@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSShieldEmergencyContact_basic(t *testing.T) {
+	resourceName := "aws_shield_emergency_contact.test"
+
+	// The emergency contacts are a per-account setting, so these tests are not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSShield(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSShieldEmergencyContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShieldEmergencyContactConfig("ops@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldEmergencyContactExists(resourceName),
+					testAccCheckResourceAttrAccountID(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "ops@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccShieldEmergencyContactConfigMultiple("ops@example.com", "security@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSShieldEmergencyContactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "ops@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "security@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSShieldEmergencyContactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_emergency_contact" {
+			continue
+		}
+
+		output, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+
+		if isAWSErr(err, shield.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.EmergencyContactList) != 0 {
+			return fmt.Errorf("Shield Emergency Contact (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSShieldEmergencyContactExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+
+		output, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.EmergencyContactList) == 0 {
+			return fmt.Errorf("Shield Emergency Contact (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccShieldEmergencyContactConfig(emailAddress string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_emergency_contact" "test" {
+  emergency_contact {
+    email_address = %[1]q
+  }
+}
+`, emailAddress)
+}
+
+func testAccShieldEmergencyContactConfigMultiple(emailAddress1, emailAddress2 string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_emergency_contact" "test" {
+  emergency_contact {
+    email_address = %[1]q
+  }
+
+  emergency_contact {
+    email_address = %[2]q
+  }
+}
+`, emailAddress1, emailAddress2)
+}
@@ -270,6 +270,70 @@ func TestAccAWSVpc_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpc_DefaultTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigDefaultTags("provider", "default"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "provider", "default"),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-vpc-default-tags"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "terraform-testacc-vpc-default-tags"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.provider", "default"),
+				),
+			},
+			{
+				Config: testAccVpcConfigDefaultTags("Name", "overridden"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckTags(&vpc.Tags, "provider", ""),
+					testAccCheckTags(&vpc.Tags, "Name", "terraform-testacc-vpc-default-tags"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", "terraform-testacc-vpc-default-tags"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpc_IgnoreTags(t *testing.T) {
+	var vpc ec2.Vpc
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists(resourceName, &vpc),
+					testAccCheckVpcAddTag(&vpc, "ignorekey1", "ignorevalue1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVpcConfigIgnoreTags,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpc_update(t *testing.T) {
 	var vpc ec2.Vpc
 	resourceName := "aws_vpc.test"
@@ -332,6 +396,25 @@ func testAccCheckVpcDestroy(s *terraform.State) error {
 	return nil
 }
 
+// testAccCheckVpcAddTag adds a tag to the VPC outside of Terraform.
+func testAccCheckVpcAddTag(vpc *ec2.Vpc, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{vpc.VpcId},
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				},
+			},
+		})
+
+		return err
+	}
+}
+
 func testAccCheckVpcCidr(vpc *ec2.Vpc, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(vpc.CidrBlock) != expected {
@@ -561,6 +644,44 @@ resource "aws_vpc" "test" {
 	}
 }
 `
+
+func testAccVpcConfigDefaultTags(key, value string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = "terraform-testacc-vpc-default-tags"
+  }
+}
+`, key, value)
+}
+
+const testAccVpcConfigIgnoreTags = `
+provider "aws" {
+  ignore_tags {
+    key_prefixes = ["ignorekey"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    foo  = "bar"
+    Name = "terraform-testacc-vpc-tags"
+  }
+}
+`
+
 const testAccVpcDedicatedConfig = `
 resource "aws_vpc" "test" {
 	instance_tenancy = "dedicated"
@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsWorkspacesIpGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesIpGroupCreate,
+		Read:   resourceAwsWorkspacesIpGroupRead,
+		Update: resourceAwsWorkspacesIpGroupUpdate,
+		Delete: resourceAwsWorkspacesIpGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(0, 32),
+						},
+					},
+				},
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+		},
+	}
+}
+
+func resourceAwsWorkspacesIpGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+	name := d.Get("name").(string)
+
+	input := &workspaces.CreateIpGroupInput{
+		GroupName: aws.String(name),
+		Tags:      keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().WorkspacesTags(),
+		UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.GroupDesc = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces IP Group: %s", input)
+	output, err := conn.CreateIpGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces IP Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.GroupId))
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+		GroupIds: []*string{aws.String(d.Id())},
+	})
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Result) == 0 || output.Result[0] == nil {
+		log.Printf("[WARN] WorkSpaces IP Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	ipGroup := output.Result[0]
+
+	d.Set("description", ipGroup.GroupDesc)
+	d.Set("name", ipGroup.GroupName)
+
+	if err := d.Set("rules", flattenWorkspacesIpGroupRules(ipGroup.UserRules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
+
+	tags, err := keyvaluetags.WorkspacesListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesIpGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	if d.HasChange("rules") {
+		input := &workspaces.UpdateRulesOfIpGroupInput{
+			GroupId:   aws.String(d.Id()),
+			UserRules: expandWorkspacesIpGroupRules(d.Get("rules").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces IP Group rules: %s", input)
+		if _, err := conn.UpdateRulesOfIpGroup(input); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) rules: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces IP Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesIpGroupRead(d, meta)
+}
+
+func resourceAwsWorkspacesIpGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.DeleteIpGroupInput{
+		GroupId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting WorkSpaces IP Group: %s", input)
+	_, err := conn.DeleteIpGroup(input)
+
+	if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting WorkSpaces IP Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkspacesIpGroupRules(l []interface{}) []*workspaces.IpRuleItem {
+	rules := make([]*workspaces.IpRuleItem, 0, len(l))
+
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := &workspaces.IpRuleItem{
+			IpRule: aws.String(m["source"].(string)),
+		}
+
+		if v, ok := m["description"].(string); ok && v != "" {
+			rule.RuleDesc = aws.String(v)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenWorkspacesIpGroupRules(rules []*workspaces.IpRuleItem) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"description": aws.StringValue(rule.RuleDesc),
+			"source":      aws.StringValue(rule.IpRule),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsWorkspacesIpGroup_basic(t *testing.T) {
+	var ipGroup workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesIpGroup_Rules(t *testing.T) {
+	var ipGroup workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigRules1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "description", "Office"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigRules2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesIpGroup_Tags(t *testing.T) {
+	var ipGroup workspaces.IpGroup
+	resourceName := "aws_workspaces_ip_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesIpGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesIpGroupConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesIpGroupExists(resourceName, &ipGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsWorkspacesIpGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_ip_group" {
+			continue
+		}
+
+		output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if isAWSErr(err, workspaces.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Result) > 0 {
+			return fmt.Errorf("WorkSpaces IP Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesIpGroupExists(resourceName string, ipGroup *workspaces.IpGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces IP Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		output, err := conn.DescribeIpGroups(&workspaces.DescribeIpGroupsInput{
+			GroupIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Result) == 0 || output.Result[0] == nil {
+			return fmt.Errorf("WorkSpaces IP Group (%s) not found", rs.Primary.ID)
+		}
+
+		*ipGroup = *output.Result[0]
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesIpGroupConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesIpGroupConfigRules1(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Office"
+
+  rules {
+    source      = "10.0.0.0/16"
+    description = "VPN"
+  }
+
+  rules {
+    source = "192.0.2.0/24"
+  }
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesIpGroupConfigRules2(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name        = %[1]q
+  description = "Office"
+
+  rules {
+    source      = "198.51.100.0/24"
+    description = "Branch"
+  }
+}
+`, rName)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAwsWorkspacesIpGroupConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspaces_ip_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,478 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsWorkspacesWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsWorkspacesWorkspaceCreate,
+		Read:   resourceAwsWorkspacesWorkspaceRead,
+		Update: resourceAwsWorkspacesWorkspaceUpdate,
+		Delete: resourceAwsWorkspacesWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bundle_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"computer_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"directory_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"root_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaTrulyComputed(),
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_volume_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"volume_encryption_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workspace_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compute_type_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.ComputeGraphics,
+								workspaces.ComputeGraphicspro,
+								workspaces.ComputePerformance,
+								workspaces.ComputePower,
+								workspaces.ComputePowerpro,
+								workspaces.ComputeStandard,
+								workspaces.ComputeValue,
+							}, false),
+						},
+						"root_volume_size_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"running_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								workspaces.RunningModeAlwaysOn,
+								workspaces.RunningModeAutoStop,
+							}, false),
+						},
+						"running_mode_auto_stop_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateWorkspacesRunningModeAutoStopTimeout,
+						},
+						"user_volume_size_gib": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateWorkspacesRunningModeAutoStopTimeout requires the timeout to be in 60-minute intervals.
+func validateWorkspacesRunningModeAutoStopTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+
+	if value <= 0 || value%60 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive multiple of 60, got: %d", k, value))
+	}
+
+	return
+}
+
+func resourceAwsWorkspacesWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	request := &workspaces.WorkspaceRequest{
+		BundleId:                    aws.String(d.Get("bundle_id").(string)),
+		DirectoryId:                 aws.String(d.Get("directory_id").(string)),
+		RootVolumeEncryptionEnabled: aws.Bool(d.Get("root_volume_encryption_enabled").(bool)),
+		Tags:                        keyvaluetags.New(d.Get("tags_all").(map[string]interface{})).IgnoreAws().WorkspacesTags(),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+		UserVolumeEncryptionEnabled: aws.Bool(d.Get("user_volume_encryption_enabled").(bool)),
+		WorkspaceProperties:         expandWorkspacesWorkspaceProperties(d.Get("workspace_properties").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("volume_encryption_key"); ok {
+		request.VolumeEncryptionKey = aws.String(v.(string))
+	}
+
+	input := &workspaces.CreateWorkspacesInput{
+		Workspaces: []*workspaces.WorkspaceRequest{request},
+	}
+
+	log.Printf("[DEBUG] Creating WorkSpaces Workspace: %s", input)
+	output, err := conn.CreateWorkspaces(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s", err)
+	}
+
+	// Failures for individual workspaces are reported in the response rather than as an error.
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+		return fmt.Errorf("error creating WorkSpaces Workspace: %s: %s", aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	if len(output.PendingRequests) == 0 || output.PendingRequests[0] == nil {
+		return fmt.Errorf("error creating WorkSpaces Workspace: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.PendingRequests[0].WorkspaceId))
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(), []string{workspaces.WorkspaceStatePending, workspaces.WorkspaceStateStarting}, []string{workspaces.WorkspaceStateAvailable, workspaces.WorkspaceStateStopped}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	workspace, err := describeWorkspacesWorkspace(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if workspace == nil || aws.StringValue(workspace.State) == workspaces.WorkspaceStateTerminated {
+		log.Printf("[WARN] WorkSpaces Workspace (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("bundle_id", workspace.BundleId)
+	d.Set("computer_name", workspace.ComputerName)
+	d.Set("directory_id", workspace.DirectoryId)
+	d.Set("ip_address", workspace.IpAddress)
+	d.Set("root_volume_encryption_enabled", workspace.RootVolumeEncryptionEnabled)
+	d.Set("state", workspace.State)
+	d.Set("user_name", workspace.UserName)
+	d.Set("user_volume_encryption_enabled", workspace.UserVolumeEncryptionEnabled)
+	d.Set("volume_encryption_key", workspace.VolumeEncryptionKey)
+
+	if err := d.Set("workspace_properties", flattenWorkspacesWorkspaceProperties(workspace.WorkspaceProperties)); err != nil {
+		return fmt.Errorf("error setting workspace_properties: %s", err)
+	}
+
+	tags, err := keyvaluetags.WorkspacesListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if err := setTagsAll(d, meta, tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsWorkspacesWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	// Only one workspace property can be modified per call, so each change is applied
+	// separately, waiting for the workspace to settle in between.
+	if d.HasChange("workspace_properties.0.compute_type_name") {
+		if err := updateWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			ComputeTypeName: aws.String(d.Get("workspace_properties.0.compute_type_name").(string)),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.root_volume_size_gib") {
+		if err := updateWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			RootVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.root_volume_size_gib").(int))),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.running_mode") || d.HasChange("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes") {
+		properties := &workspaces.WorkspaceProperties{
+			RunningMode: aws.String(d.Get("workspace_properties.0.running_mode").(string)),
+		}
+
+		if v, ok := d.GetOk("workspace_properties.0.running_mode_auto_stop_timeout_in_minutes"); ok && aws.StringValue(properties.RunningMode) == workspaces.RunningModeAutoStop {
+			properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v.(int)))
+		}
+
+		if err := updateWorkspacesWorkspaceProperties(conn, d, properties); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("workspace_properties.0.user_volume_size_gib") {
+		if err := updateWorkspacesWorkspaceProperties(conn, d, &workspaces.WorkspaceProperties{
+			UserVolumeSizeGib: aws.Int64(int64(d.Get("workspace_properties.0.user_volume_size_gib").(int))),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := keyvaluetags.WorkspacesUpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating WorkSpaces Workspace (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsWorkspacesWorkspaceRead(d, meta)
+}
+
+func resourceAwsWorkspacesWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).workspacesconn
+
+	input := &workspaces.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*workspaces.TerminateRequest{
+			{
+				WorkspaceId: aws.String(d.Id()),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Terminating WorkSpaces Workspace: %s", input)
+	output, err := conn.TerminateWorkspaces(input)
+
+	if err != nil {
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s", d.Id(), err)
+	}
+
+	if len(output.FailedRequests) > 0 {
+		failure := output.FailedRequests[0]
+		return fmt.Errorf("error terminating WorkSpaces Workspace (%s): %s: %s", d.Id(), aws.StringValue(failure.ErrorCode), aws.StringValue(failure.ErrorMessage))
+	}
+
+	if err := waitForWorkspacesWorkspaceTermination(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) termination: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateWorkspacesWorkspaceProperties(conn *workspaces.WorkSpaces, d *schema.ResourceData, properties *workspaces.WorkspaceProperties) error {
+	input := &workspaces.ModifyWorkspacePropertiesInput{
+		WorkspaceId:         aws.String(d.Id()),
+		WorkspaceProperties: properties,
+	}
+
+	log.Printf("[DEBUG] Modifying WorkSpaces Workspace properties: %s", input)
+	if _, err := conn.ModifyWorkspaceProperties(input); err != nil {
+		return fmt.Errorf("error modifying WorkSpaces Workspace (%s) properties: %s", d.Id(), err)
+	}
+
+	if err := waitForWorkspacesWorkspaceState(conn, d.Id(), []string{workspaces.WorkspaceStateUpdating}, []string{workspaces.WorkspaceStateAvailable, workspaces.WorkspaceStateStopped}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for WorkSpaces Workspace (%s) update: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeWorkspacesWorkspace(conn *workspaces.WorkSpaces, id string) (*workspaces.Workspace, error) {
+	output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
+		WorkspaceIds: []*string{aws.String(id)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Workspaces) == 0 {
+		return nil, nil
+	}
+
+	return output.Workspaces[0], nil
+}
+
+func workspacesWorkspaceStateRefreshFunc(conn *workspaces.WorkSpaces, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		workspace, err := describeWorkspacesWorkspace(conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if workspace == nil {
+			return nil, "", nil
+		}
+
+		state := aws.StringValue(workspace.State)
+
+		if state == workspaces.WorkspaceStateError {
+			return workspace, state, fmt.Errorf("%s: %s", aws.StringValue(workspace.ErrorCode), aws.StringValue(workspace.ErrorMessage))
+		}
+
+		return workspace, state, nil
+	}
+}
+
+func waitForWorkspacesWorkspaceState(conn *workspaces.WorkSpaces, id string, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    workspacesWorkspaceStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) to become %v", id, target)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForWorkspacesWorkspaceTermination(conn *workspaces.WorkSpaces, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			workspaces.WorkspaceStateAdminMaintenance,
+			workspaces.WorkspaceStateAvailable,
+			workspaces.WorkspaceStateImpaired,
+			workspaces.WorkspaceStateMaintenance,
+			workspaces.WorkspaceStatePending,
+			workspaces.WorkspaceStateRebooting,
+			workspaces.WorkspaceStateRebuilding,
+			workspaces.WorkspaceStateStarting,
+			workspaces.WorkspaceStateStopped,
+			workspaces.WorkspaceStateStopping,
+			workspaces.WorkspaceStateSuspended,
+			workspaces.WorkspaceStateTerminating,
+			workspaces.WorkspaceStateUnhealthy,
+			workspaces.WorkspaceStateUpdating,
+		},
+		Target: []string{workspaces.WorkspaceStateTerminated},
+		Refresh: func() (interface{}, string, error) {
+			workspace, state, err := workspacesWorkspaceStateRefreshFunc(conn, id)()
+
+			// Terminated workspaces are eventually no longer returned at all.
+			if err == nil && workspace == nil {
+				return &workspaces.Workspace{}, workspaces.WorkspaceStateTerminated, nil
+			}
+
+			return workspace, state, err
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for WorkSpaces Workspace (%s) termination", id)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func expandWorkspacesWorkspaceProperties(l []interface{}) *workspaces.WorkspaceProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &workspaces.WorkspaceProperties{}
+
+	if v, ok := m["compute_type_name"].(string); ok && v != "" {
+		properties.ComputeTypeName = aws.String(v)
+	}
+
+	if v, ok := m["root_volume_size_gib"].(int); ok && v > 0 {
+		properties.RootVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["running_mode"].(string); ok && v != "" {
+		properties.RunningMode = aws.String(v)
+	}
+
+	if v, ok := m["running_mode_auto_stop_timeout_in_minutes"].(int); ok && v > 0 && aws.StringValue(properties.RunningMode) == workspaces.RunningModeAutoStop {
+		properties.RunningModeAutoStopTimeoutInMinutes = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["user_volume_size_gib"].(int); ok && v > 0 {
+		properties.UserVolumeSizeGib = aws.Int64(int64(v))
+	}
+
+	return properties
+}
+
+func flattenWorkspacesWorkspaceProperties(properties *workspaces.WorkspaceProperties) []interface{} {
+	if properties == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"compute_type_name":                         aws.StringValue(properties.ComputeTypeName),
+		"root_volume_size_gib":                      int(aws.Int64Value(properties.RootVolumeSizeGib)),
+		"running_mode":                              aws.StringValue(properties.RunningMode),
+		"running_mode_auto_stop_timeout_in_minutes": int(aws.Int64Value(properties.RunningModeAutoStopTimeoutInMinutes)),
+		"user_volume_size_gib":                      int(aws.Int64Value(properties.UserVolumeSizeGib)),
+	}
+
+	return []interface{}{m}
+}
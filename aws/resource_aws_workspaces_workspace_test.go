@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsWorkspacesWorkspace_basic(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+	directoryID, userName := testAccAwsWorkspacesWorkspaceEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigBasic(directoryID, userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttrPair(resourceName, "bundle_id", "data.aws_workspaces_bundle.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "computer_name"),
+					resource.TestCheckResourceAttr(resourceName, "directory_id", directoryID),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", userName),
+					resource.TestCheckResourceAttr(resourceName, "user_volume_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "ALWAYS_ON"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesWorkspace_WorkspaceProperties(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+	directoryID, userName := testAccAwsWorkspacesWorkspaceEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, userName, "AUTO_STOP", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.compute_type_name", "VALUE"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode", "AUTO_STOP"),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, userName, "AUTO_STOP", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "workspace_properties.0.running_mode_auto_stop_timeout_in_minutes", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsWorkspacesWorkspace_Tags(t *testing.T) {
+	var workspace workspaces.Workspace
+	resourceName := "aws_workspaces_workspace.test"
+	directoryID, userName := testAccAwsWorkspacesWorkspaceEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsWorkspacesWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, userName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, userName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsWorkspacesWorkspaceExists(resourceName, &workspace),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAwsWorkspacesWorkspaceEnv returns the ID of a directory already registered with WorkSpaces
+// and the name of a user in that directory.
+func testAccAwsWorkspacesWorkspaceEnv(t *testing.T) (string, string) {
+	directoryID := os.Getenv("WORKSPACES_DIRECTORY_ID")
+	userName := os.Getenv("WORKSPACES_USER_NAME")
+
+	if directoryID == "" || userName == "" {
+		t.Skip("Environment variables WORKSPACES_DIRECTORY_ID and WORKSPACES_USER_NAME must be set")
+	}
+
+	return directoryID, userName
+}
+
+func testAccCheckAwsWorkspacesWorkspaceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_workspaces_workspace" {
+			continue
+		}
+
+		workspace, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if workspace != nil && *workspace.State != workspaces.WorkspaceStateTerminated {
+			return fmt.Errorf("WorkSpaces Workspace (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsWorkspacesWorkspaceExists(resourceName string, workspace *workspaces.Workspace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No WorkSpaces Workspace ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+
+		output, err := describeWorkspacesWorkspace(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("WorkSpaces Workspace (%s) not found", rs.Primary.ID)
+		}
+
+		*workspace = *output
+
+		return nil
+	}
+}
+
+func testAccAwsWorkspacesWorkspaceConfigBase() string {
+	return `
+data "aws_workspaces_bundle" "test" {
+  bundle_id = "wsb-bh8rsxt14" # Value with Windows 10 (English)
+}
+`
+}
+
+func testAccAwsWorkspacesWorkspaceConfigBasic(directoryID, userName string) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = %[2]q
+}
+`, directoryID, userName)
+}
+
+func testAccAwsWorkspacesWorkspaceConfigWorkspaceProperties(directoryID, userName, runningMode string, autoStopTimeout int) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = %[2]q
+
+  workspace_properties {
+    compute_type_name                         = "VALUE"
+    running_mode                              = %[3]q
+    running_mode_auto_stop_timeout_in_minutes = %[4]d
+  }
+}
+`, directoryID, userName, runningMode, autoStopTimeout)
+}
+
+func testAccAwsWorkspacesWorkspaceConfigTags1(directoryID, userName, tagKey1, tagValue1 string) string {
+	return testAccAwsWorkspacesWorkspaceConfigBase() + fmt.Sprintf(`
+resource "aws_workspaces_workspace" "test" {
+  bundle_id    = "${data.aws_workspaces_bundle.test.id}"
+  directory_id = %[1]q
+  user_name    = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, directoryID, userName, tagKey1, tagValue1)
+}
@@ -750,6 +750,87 @@ func flattenEcsContainerDefinitions(definitions []*ecs.ContainerDefinition) (str
 	return string(b), nil
 }
 
+// flattenAwsSdkSettingsJson returns the JSON encoding of an AWS SDK structure,
+// e.g. MediaConvert preset settings. Keys are the structure field names and
+// unset fields are omitted.
+func flattenAwsSdkSettingsJson(settings interface{}) (string, error) {
+	b, err := json.Marshal(settings)
+	if err != nil {
+		return "", err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return "", err
+	}
+
+	b, err = json.Marshal(omitJsonNullValues(v))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// omitJsonNullValues removes null values from a decoded JSON document, as
+// encoding/json encodes unset AWS SDK structure fields as null.
+func omitJsonNullValues(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			if e == nil {
+				continue
+			}
+			m[k] = omitJsonNullValues(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			if e == nil {
+				continue
+			}
+			l = append(l, omitJsonNullValues(e))
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+// flattenAwsSdkSettingsJsonWithConfigured returns the JSON to store in state
+// for an AWS SDK structure read from the API. Such APIs fill in defaults for
+// settings that were not specified, so the configured JSON is returned when
+// all of its settings match the API values. This keeps the API defaults out of
+// state while still detecting drift and the removal of configured settings.
+func flattenAwsSdkSettingsJsonWithConfigured(settings interface{}, configured string, newSettings func() interface{}) (string, error) {
+	settingsJSON, err := flattenAwsSdkSettingsJson(settings)
+	if err != nil {
+		return "", err
+	}
+
+	if configured == "" {
+		return settingsJSON, nil
+	}
+
+	configuredValue, err := normalizeAwsSdkSettingsJson(configured, newSettings())
+	if err != nil {
+		return settingsJSON, nil
+	}
+
+	settingsValue, err := normalizeAwsSdkSettingsJson(settingsJSON, newSettings())
+	if err != nil {
+		return "", err
+	}
+
+	if awsSdkSettingsJsonSubset(configuredValue, settingsValue) {
+		return configured, nil
+	}
+
+	return settingsJSON, nil
+}
+
 // Flattens an array of Options into a []map[string]interface{}
 func flattenOptions(apiOptions []*rds.Option, optionConfigurations []*rds.OptionConfiguration) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
@@ -1633,14 +1714,6 @@ func expandCloudFormationTags(tags map[string]interface{}) []*cloudformation.Tag
 	return cfTags
 }
 
-func flattenCloudFormationTags(cfTags []*cloudformation.Tag) map[string]string {
-	tags := make(map[string]string, len(cfTags))
-	for _, t := range cfTags {
-		tags[*t.Key] = *t.Value
-	}
-	return tags
-}
-
 func flattenCloudFormationOutputs(cfOutputs []*cloudformation.Output) map[string]string {
 	outputs := make(map[string]string, len(cfOutputs))
 	for _, o := range cfOutputs {
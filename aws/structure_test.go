@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -1521,3 +1522,43 @@ const testExampleXML_from_msdn_flawed = `
     </items>
 </purchaseOrder>
 `
+
+func TestFlattenAwsSdkSettingsJsonWithConfigured(t *testing.T) {
+	settings := &mediaconvert.PresetSettings{
+		ContainerSettings: &mediaconvert.ContainerSettings{
+			Container: aws.String("MP4"),
+			Mp4Settings: &mediaconvert.Mp4Settings{
+				CslgAtom: aws.String("INCLUDE"),
+			},
+		},
+	}
+
+	testCases := []struct {
+		Configured string
+		Expected   string
+	}{
+		{
+			Configured: `{"ContainerSettings":{"Container":"MP4"}}`,
+			Expected:   `{"ContainerSettings":{"Container":"MP4"}}`,
+		},
+		{
+			Configured: `{"ContainerSettings":{"Container":"MOV"}}`,
+			Expected:   `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"INCLUDE"}}}`,
+		},
+		{
+			Configured: "",
+			Expected:   `{"ContainerSettings":{"Container":"MP4","Mp4Settings":{"CslgAtom":"INCLUDE"}}}`,
+		},
+	}
+
+	for i, tc := range testCases {
+		got, err := flattenAwsSdkSettingsJsonWithConfigured(settings, tc.Configured, func() interface{} { return &mediaconvert.PresetSettings{} })
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+
+		if got != tc.Expected {
+			t.Errorf("%d: expected %s, got %s", i, tc.Expected, got)
+		}
+	}
+}
@@ -3,17 +3,16 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -41,49 +40,68 @@ func tagsSchemaForceNew() *schema.Schema {
 	}
 }
 
-func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
+// tagsSchemaTrulyComputed returns the schema to use for the "tags_all"
+// attribute, which holds the tags of a resource merged with the provider
+// default tags.
+func tagsSchemaTrulyComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
-			})
-			if err != nil {
-				return err
-			}
-		}
+// tagsSchemaTrulyComputedForceNew returns the schema to use for the
+// "tags_all" attribute of resources whose tags cannot be updated in place.
+func tagsSchemaTrulyComputedForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		ForceNew: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
+}
 
-	return nil
+// setTagsDiff is a CustomizeDiff function computing "tags_all" from the
+// resource "tags" and the provider default tags.
+func setTagsDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	defaultTagsConfig := meta.(*AWSClient).defaultTagsConfig
+	allTags := defaultTagsConfig.MergeTags(keyvaluetags.New(diff.Get("tags").(map[string]interface{})))
+
+	if allTags.Equal(keyvaluetags.New(diff.Get("tags_all").(map[string]interface{}))) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", allTags.Map())
+}
+
+// setTagsAll sets "tags_all" to the tags read from the resource, minus any
+// ignored tags, and "tags" to the same tags without any unconfigured provider
+// default tags.
+func setTagsAll(d *schema.ResourceData, meta interface{}, tags map[string]string) error {
+	defaultTagsConfig := meta.(*AWSClient).defaultTagsConfig
+	ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+	allTags := keyvaluetags.New(tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+	configuredTags := keyvaluetags.New(d.Get("tags").(map[string]interface{}))
+
+	if err := d.Set("tags", defaultTagsConfig.RemoveDefaultConfig(allTags, configuredTags).Map()); err != nil {
+		return err
+	}
+
+	return d.Set("tags_all", allTags.Map())
 }
 
 func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	if d.HasChange("volume_tags") {
-		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		o, n := d.GetChange("volume_tags")
+		oldTags := keyvaluetags.New(o)
+		newTags := keyvaluetags.New(n)
+		create := oldTags.Updated(newTags).IgnoreAws().Ec2Tags()
+		remove := oldTags.Removed(newTags).IgnoreAws().Ec2Tags()
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
@@ -159,215 +177,6 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	return nil
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-					_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      remove,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				// Retry without time bounds for EC2 throttling
-				if isResourceTimeoutError(err) {
-					log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-					_, err := conn.CreateTags(&ec2.CreateTagsInput{
-						Resources: []*string{aws.String(d.Id())},
-						Tags:      create,
-					})
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
-	}
-
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[aws.StringValue(t.Key)]
-		if !ok || old != aws.StringValue(t.Value) {
-			remove = append(remove, t)
-		} else if ok {
-			// already present so remove from new
-			delete(create, aws.StringValue(t.Key))
-		}
-	}
-
-	return tagsFromMap(create), remove
-}
-
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapELBv2(create), remove
-}
-
-// tagsToMapELBv2 turns the list of tags into a map.
-func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
-}
-
-// tagsFromMapELBv2 returns the tags for the given map of data.
-func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
-}
-
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
-
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		r, _ := regexp.MatchString(v, *t.Key)
-		if r {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
-}
-
 // tagsMapToHash returns a stable hash value for a raw tags map.
 // The returned value map be negative (i.e. not suitable for a 'Set' function).
 func tagsMapToHash(tags map[string]interface{}) int {
@@ -397,7 +206,7 @@ func ec2TagSpecificationsFromMap(m map[string]interface{}, t string) []*ec2.TagS
 	return []*ec2.TagSpecification{
 		{
 			ResourceType: aws.String(t),
-			Tags:         tagsFromMap(m),
+			Tags:         keyvaluetags.New(m).IgnoreAws().Ec2Tags(),
 		},
 	}
 }